@@ -0,0 +1,125 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/hd"
+	"github.com/cosmos/evm/crypto/keystore"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagKDF    = "kdf"
+	flagOutput = "output-file"
+)
+
+// ImportEthKeystoreCommand imports the private key of an Ethereum keystore file.
+func ImportEthKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-eth-keystore <name> <keyfile>",
+		Short: "Import an Ethereum keystore file into the local keybase",
+		Long:  "Import the private key of a version 3 Ethereum keystore file, encrypted with a scrypt or pbkdf2 derived key, into the local keybase.",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runImportKeystoreCmd,
+	}
+}
+
+func runImportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	keyJSON, err := os.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	passphrase, err := input.GetPassword("Enter passphrase to decrypt the keystore:", inBuf)
+	if err != nil {
+		return err
+	}
+
+	privKey, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return err
+	}
+
+	armor := crypto.EncryptArmorPrivKey(privKey, passphrase, ethsecp256k1.KeyType)
+
+	return clientCtx.Keyring.ImportPrivKey(args[0], armor, passphrase)
+}
+
+// ExportEthKeystoreCommand exports a key with the given name as an Ethereum keystore file.
+func ExportEthKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-eth-keystore <name>",
+		Short: "Export an Ethereum keystore file",
+		Long: `Export a key as a version 3 Ethereum keystore file, encrypted with a passphrase.
+The encryption key is derived from the passphrase with scrypt, or pbkdf2 with --kdf=pbkdf2.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExportKeystoreCmd,
+	}
+	cmd.Flags().String(flagKDF, keystore.KDFScrypt, fmt.Sprintf("Key derivation function (%s|%s)", keystore.KDFScrypt, keystore.KDFPBKDF2))
+	cmd.Flags().String(flagOutput, "", "Write the keystore file to the given path instead of stdout")
+	return cmd
+}
+
+func runExportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	kdf, err := cmd.Flags().GetString(flagKDF)
+	if err != nil {
+		return err
+	}
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	decryptPassword := ""
+	if clientCtx.Keyring.Backend() == keyring.BackendFile {
+		decryptPassword, err = input.GetPassword("Enter key password:", inBuf)
+		if err != nil {
+			return err
+		}
+	}
+
+	privKey, err := exportEthPrivKey(clientCtx.Keyring, args[0], decryptPassword)
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to encrypt the keystore:", inBuf)
+	if err != nil {
+		return err
+	}
+
+	keyJSON, err := keystore.EncryptKey(privKey, passphrase, kdf)
+	if err != nil {
+		return err
+	}
+
+	if output != "" {
+		return os.WriteFile(output, keyJSON, 0o600)
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(keyJSON))
+	return err
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptocodec "github.com/cosmos/evm/crypto/codec"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/hd"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+func TestExportEthPrivKey(t *testing.T) {
+	cryptocodec.RegisterCrypto(codec.NewLegacyAmino())
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	dir := t.TempDir()
+
+	// the file keyring is created with the keyring password
+	kr, err := keyring.New("evm", keyring.BackendFile, dir, strings.NewReader("password\npassword\n"), cdc, hd.EthSecp256k1Option())
+	require.NoError(t, err)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	armor := crypto.EncryptArmorPrivKey(priv, "armor", ethsecp256k1.KeyType)
+	require.NoError(t, kr.ImportPrivKey("key", armor, "armor"))
+
+	// the export fails without the keyring password
+	kr, err = keyring.New("evm", keyring.BackendFile, dir, strings.NewReader("wrong\nwrong\nwrong\n"), cdc, hd.EthSecp256k1Option())
+	require.NoError(t, err)
+	_, err = exportEthPrivKey(kr, "key", "")
+	require.Error(t, err)
+
+	kr, err = keyring.New("evm", keyring.BackendFile, dir, strings.NewReader("password\n"), cdc, hd.EthSecp256k1Option())
+	require.NoError(t, err)
+	exported, err := exportEthPrivKey(kr, "key", "")
+	require.NoError(t, err)
+	require.Equal(t, priv.Key, exported.Key)
+}
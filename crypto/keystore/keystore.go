@@ -0,0 +1,127 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	ethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+)
+
+const (
+	// KDFScrypt is the scrypt key derivation function, used by default by geth.
+	KDFScrypt = "scrypt"
+	// KDFPBKDF2 is the PBKDF2 key derivation function with HMAC-SHA256.
+	KDFPBKDF2 = "pbkdf2"
+
+	// pbkdf2Iterations is the iteration count of the PBKDF2 key derivation,
+	// as set by the web3 keystore implementations.
+	pbkdf2Iterations = 262144
+	// keyHeaderKDFLen is the length of the derived key, which holds the
+	// encryption key and the MAC key.
+	keyHeaderKDFLen = 32
+	// version is the version of the keystore files.
+	version = 3
+)
+
+// encryptedKeyJSONV3 is a version 3 Ethereum keystore file.
+type encryptedKeyJSONV3 struct {
+	Address string                 `json:"address"`
+	Crypto  ethkeystore.CryptoJSON `json:"crypto"`
+	ID      string                 `json:"id"`
+	Version int                    `json:"version"`
+}
+
+// DecryptKey decrypts the private key of an Ethereum keystore file, whose key
+// is derived with either scrypt or PBKDF2.
+func DecryptKey(keyJSON []byte, passphrase string) (*ethsecp256k1.PrivKey, error) {
+	key, err := ethkeystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	return &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(key.PrivateKey)}, nil
+}
+
+// EncryptKey encrypts the private key into a version 3 Ethereum keystore file,
+// deriving the encryption key from the passphrase with the kdf.
+func EncryptKey(privKey *ethsecp256k1.PrivKey, passphrase, kdf string) ([]byte, error) {
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return nil, err
+	}
+
+	var cryptoJSON ethkeystore.CryptoJSON
+	switch kdf {
+	case KDFScrypt:
+		cryptoJSON, err = ethkeystore.EncryptDataV3(privKey.Key, []byte(passphrase), ethkeystore.StandardScryptN, ethkeystore.StandardScryptP)
+	case KDFPBKDF2:
+		cryptoJSON, err = encryptDataPBKDF2(privKey.Key, passphrase)
+	default:
+		return nil, fmt.Errorf("unsupported kdf %q, expected %s or %s", kdf, KDFScrypt, KDFPBKDF2)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	return json.Marshal(encryptedKeyJSONV3{
+		Address: hex.EncodeToString(address.Bytes()),
+		Crypto:  cryptoJSON,
+		ID:      id.String(),
+		Version: version,
+	})
+}
+
+// encryptDataPBKDF2 encrypts data with AES-128-CTR and a key derived from the
+// passphrase with PBKDF2, as EncryptDataV3 does with scrypt.
+func encryptDataPBKDF2(data []byte, passphrase string) (ethkeystore.CryptoJSON, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return ethkeystore.CryptoJSON{}, err
+	}
+	derivedKey, err := pbkdf2.Key(sha256.New, passphrase, salt, pbkdf2Iterations, keyHeaderKDFLen)
+	if err != nil {
+		return ethkeystore.CryptoJSON{}, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return ethkeystore.CryptoJSON{}, err
+	}
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return ethkeystore.CryptoJSON{}, err
+	}
+	cipherText := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, data)
+	mac := crypto.Keccak256(derivedKey[16:32], cipherText)
+
+	cryptoJSON := ethkeystore.CryptoJSON{
+		Cipher:     "aes-128-ctr",
+		CipherText: hex.EncodeToString(cipherText),
+		KDF:        KDFPBKDF2,
+		KDFParams: map[string]interface{}{
+			"c":     pbkdf2Iterations,
+			"dklen": keyHeaderKDFLen,
+			"prf":   "hmac-sha256",
+			"salt":  hex.EncodeToString(salt),
+		},
+		MAC: hex.EncodeToString(mac),
+	}
+	cryptoJSON.CipherParams.IV = hex.EncodeToString(iv)
+	return cryptoJSON, nil
+}
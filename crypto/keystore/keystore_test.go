@@ -0,0 +1,62 @@
+package keystore
+
+import (
+	"encoding/json"
+	"testing"
+
+	ethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+)
+
+func TestEncryptDecryptKey(t *testing.T) {
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	key, err := privKey.ToECDSA()
+	require.NoError(t, err)
+
+	for _, kdf := range []string{KDFScrypt, KDFPBKDF2} {
+		t.Run(kdf, func(t *testing.T) {
+			keyJSON, err := EncryptKey(privKey, "passphrase", kdf)
+			require.NoError(t, err)
+
+			var file map[string]interface{}
+			require.NoError(t, json.Unmarshal(keyJSON, &file))
+			require.Equal(t, float64(3), file["version"])
+			require.Equal(t, kdf, file["crypto"].(map[string]interface{})["kdf"])
+
+			// the file can be decrypted by geth
+			gethKey, err := ethkeystore.DecryptKey(keyJSON, "passphrase")
+			require.NoError(t, err)
+			require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), gethKey.Address)
+
+			decrypted, err := DecryptKey(keyJSON, "passphrase")
+			require.NoError(t, err)
+			require.Equal(t, privKey.Key, decrypted.Key)
+
+			_, err = DecryptKey(keyJSON, "wrong passphrase")
+			require.Error(t, err)
+		})
+	}
+
+	_, err = EncryptKey(privKey, "passphrase", "argon2")
+	require.ErrorContains(t, err, "unsupported kdf")
+}
+
+func TestDecryptGethKey(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	// light scrypt parameters keep the test fast
+	keyJSON, err := ethkeystore.EncryptKey(&ethkeystore.Key{
+		Address:    crypto.PubkeyToAddress(key.PublicKey),
+		PrivateKey: key,
+	}, "passphrase", ethkeystore.LightScryptN, ethkeystore.LightScryptP)
+	require.NoError(t, err)
+
+	privKey, err := DecryptKey(keyJSON, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, crypto.FromECDSA(key), privKey.Key)
+}
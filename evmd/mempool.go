@@ -22,11 +22,13 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 	var (
 		mpConfig = server.ResolveMempoolConfig(app.GetAnteHandler(), appOpts, logger)
 
-		txEncoder       = evmmempool.NewTxEncoder(app.txConfig)
-		evmRechecker    = evmmempool.NewTxRechecker(mpConfig.AnteHandler, txEncoder)
-		cosmosRechecker = evmmempool.NewTxRechecker(mpConfig.AnteHandler, txEncoder)
-		cosmosPoolMaxTx = server.GetCosmosPoolMaxTx(appOpts, logger)
-		checkTxTimeout  = server.GetMempoolCheckTxTimeout(appOpts, logger)
+		txEncoder        = evmmempool.NewTxEncoder(app.txConfig)
+		evmRechecker     = evmmempool.NewTxRechecker(mpConfig.AnteHandler, txEncoder)
+		cosmosRechecker  = evmmempool.NewTxRechecker(mpConfig.AnteHandler, txEncoder)
+		bundleRechecker  = evmmempool.NewTxRechecker(mpConfig.AnteHandler, txEncoder)
+		privateRechecker = evmmempool.NewTxRechecker(mpConfig.AnteHandler, txEncoder)
+		cosmosPoolMaxTx  = server.GetCosmosPoolMaxTx(appOpts, logger)
+		checkTxTimeout   = server.GetMempoolCheckTxTimeout(appOpts, logger)
 	)
 
 	if cosmosPoolMaxTx < 0 {
@@ -47,6 +49,8 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 		app.txConfig,
 		evmRechecker,
 		cosmosRechecker,
+		bundleRechecker,
+		privateRechecker,
 		mpConfig,
 		cosmosPoolMaxTx,
 	)
@@ -54,9 +58,9 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 	app.EVMMempool = mempool
 
 	// create ABCI handlers
-	prepareProposalHandler := baseapp.
-		NewDefaultProposalHandler(mempool, NewNoCheckProposalTxVerifier(app.BaseApp)).
-		PrepareProposalHandler()
+	proposalHandler := baseapp.NewDefaultProposalHandler(mempool, NewNoCheckProposalTxVerifier(app.BaseApp))
+	proposalHandler.SetTxSelector(mempool.NewTxSelector())
+	prepareProposalHandler := proposalHandler.PrepareProposalHandler()
 
 	insertTxHandler := mempool.NewInsertTxHandler(app.TxDecode)
 	reapTxsHandler := mempool.NewReapTxsHandler()
@@ -12,3 +12,13 @@ func TestKVIndexer(t *testing.T) {
 	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
 	indexer.TestKVIndexer(t, create)
 }
+
+func TestKVIndexerAddressIndexes(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestKVIndexerAddressIndexes(t, create)
+}
+
+func TestKVIndexerLogIndexes(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestKVIndexerLogIndexes(t, create)
+}
@@ -2,8 +2,10 @@ package indexer
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -23,14 +25,27 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash          = 1
+	KeyPrefixTxIndex         = 2
+	KeyPrefixAddressTx       = 3
+	KeyPrefixSenderNonce     = 4
+	KeyPrefixContractCreator = 5
+	KeyPrefixLogBlock        = 6
+	KeyPrefixTxLogs          = 7
+	KeyPrefixLogAddress      = 8
+	KeyPrefixLogTopic        = 9
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
 )
 
-var _ servertypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ servertypes.EVMTxIndexer        = &KVIndexer{}
+	_ servertypes.EVMAddressTxIndexer = &KVIndexer{}
+	_ servertypes.EVMLogIndexer       = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
@@ -57,6 +72,8 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
+	// the block logs are only marked as indexed if all of them could be decoded
+	logsIndexed := true
 	for txIndex, tx := range block.Txs {
 		result := txResults[txIndex]
 		if !evmtypes.TxSucessOrExpectedFailure(result) {
@@ -115,6 +132,27 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			if err := saveAddressIndexes(batch, ethMsg, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+
+			if result.Code != abci.CodeTypeOK {
+				continue
+			}
+			logs, err := evmtypes.DecodeMsgLogs(result.Data, msgIndex, uint64(height)) //nolint:gosec // G115 // block height won't be negative
+			if err != nil {
+				kv.logger.Error("Fail to decode logs", "err", err, "block", height, "txIndex", txIndex, "msgIndex", msgIndex)
+				logsIndexed = false
+				continue
+			}
+			if err := saveTxLogs(batch, txHash, &txResult, logs); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+		}
+	}
+	if logsIndexed {
+		if err := batch.Set(LogBlockKey(height), []byte{1}); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set log-block key", height)
 		}
 	}
 	if err := batch.Write(); err != nil {
@@ -161,6 +199,76 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*serv
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetByAddress returns the hashes of the eth txs sent from, sent to or creating
+// the address. When reverse is false, it returns the txs of the blocks after
+// blockNumber in ascending order; otherwise the txs of the blocks before
+// blockNumber in descending order, where a zero blockNumber means the latest
+// block. The txs of a block are never split across pages, so more than limit
+// hashes may be returned. The boolean result reports whether more txs remain.
+func (kv *KVIndexer) GetByAddress(address common.Address, blockNumber int64, reverse bool, limit int) ([]common.Hash, bool, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		end := AddressTxKey(address, math.MaxInt64, 0)
+		if blockNumber > 0 {
+			end = AddressTxKey(address, blockNumber, 0)
+		}
+		it, err = kv.db.ReverseIterator(AddressTxKey(address, 0, 0), end)
+	} else {
+		it, err = kv.db.Iterator(AddressTxKey(address, blockNumber+1, 0), AddressTxKey(address, math.MaxInt64, 0))
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	hashes := []common.Hash{}
+	lastHeight := int64(-1)
+	for ; it.Valid(); it.Next() {
+		height, err := parseBlockNumberFromAddressKey(it.Key())
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+		}
+		if len(hashes) >= limit && height != lastHeight {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastHeight = height
+	}
+	return hashes, false, nil
+}
+
+// GetBySenderAndNonce finds the hash of the eth tx sent by the address with
+// the given nonce, returns nil if not found.
+func (kv *KVIndexer) GetBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	bz, err := kv.db.Get(SenderNonceKey(sender, nonce))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
+// GetContractCreation finds the hash of the eth tx which deployed the contract,
+// returns nil if not found. Only contracts deployed by contract creation txs
+// are indexed, not the ones deployed by other contracts.
+func (kv *KVIndexer) GetContractCreation(contract common.Address) (*common.Hash, error) {
+	bz, err := kv.db.Get(ContractCreatorKey(contract))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreation %s", contract.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +281,25 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	key := append([]byte{KeyPrefixAddressTx}, address.Bytes()...)
+	return append(append(key, bz1...), bz2...)
+}
+
+// SenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func SenderNonceKey(sender common.Address, nonce uint64) []byte {
+	key := append([]byte{KeyPrefixSenderNonce}, sender.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// ContractCreatorKey returns the key for db entry: `contract address -> tx hash`
+func ContractCreatorKey(contract common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreator}, contract.Bytes()...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -224,6 +351,34 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveAddressIndexes index the eth tx by its sender, recipient and created
+// contract into the kv db batch
+func saveAddressIndexes(batch dbm.Batch, msg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult) error {
+	txHash := msg.Hash()
+	tx := msg.AsTransaction()
+	sender := msg.GetSender()
+
+	addresses := []common.Address{sender}
+	if tx.To() != nil {
+		addresses = append(addresses, *tx.To())
+	} else if !txResult.Failed {
+		contract := crypto.CreateAddress(sender, tx.Nonce())
+		if err := batch.Set(ContractCreatorKey(contract), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set contract-creator key")
+		}
+		addresses = append(addresses, contract)
+	}
+	for _, address := range addresses {
+		if err := batch.Set(AddressTxKey(address, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address-tx key")
+		}
+	}
+	if err := batch.Set(SenderNonceKey(sender, tx.Nonce()), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set sender-nonce key")
+	}
+	return nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -231,3 +386,12 @@ func parseBlockNumberFromKey(key []byte) (int64, error) {
 
 	return int64(sdk.BigEndianToUint64(key[1:9])), nil //#nosec G115 -- int overflow is not a concern here, block number is unlikely to exceed 9,223,372,036,854,775,807
 }
+
+func parseBlockNumberFromAddressKey(key []byte) (int64, error) {
+	if len(key) != AddressTxKeyLength {
+		return 0, fmt.Errorf("wrong address tx key length, expect: %d, got: %d", AddressTxKeyLength, len(key))
+	}
+
+	offset := 1 + common.AddressLength
+	return int64(sdk.BigEndianToUint64(key[offset : offset+8])), nil //#nosec G115 -- int overflow is not a concern here
+}
@@ -0,0 +1,234 @@
+package indexer
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxLogBlockRange is the max number of blocks of the ranges whose indexed logs
+// are looked up, it bounds the index keys scanned by a single query.
+const MaxLogBlockRange = 100_000
+
+// LogsIndexed returns true if the logs of the block have been indexed.
+func (kv *KVIndexer) LogsIndexed(height int64) (bool, error) {
+	ok, err := kv.db.Has(LogBlockKey(height))
+	if err != nil {
+		return false, errorsmod.Wrapf(err, "LogsIndexed %d", height)
+	}
+	return ok, nil
+}
+
+// GetLogsByHeight returns the logs of the eth txs of the block, grouped by tx.
+// The boolean result is false if the logs of the block have not been indexed.
+func (kv *KVIndexer) GetLogsByHeight(height int64) ([][]*ethtypes.Log, bool, error) {
+	indexed, err := kv.LogsIndexed(height)
+	if err != nil || !indexed {
+		return nil, false, err
+	}
+
+	it, err := kv.db.Iterator(TxLogsKey(height, 0), TxLogsKey(height+1, 0))
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetLogsByHeight %d", height)
+	}
+	defer it.Close()
+
+	blockLogs := [][]*ethtypes.Log{}
+	for ; it.Valid(); it.Next() {
+		txLogs, err := evmtypes.DecodeTransactionLogs(it.Value())
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "GetLogsByHeight %d", height)
+		}
+		logs := make([]*ethtypes.Log, len(txLogs.Logs))
+		for i, log := range txLogs.Logs {
+			logs[i] = log.ToEthereum()
+			logs[i].BlockTimestamp = log.BlockTimestamp
+		}
+		blockLogs = append(blockLogs, logs)
+	}
+	return blockLogs, true, nil
+}
+
+// GetLogBlocks returns, in ascending order, the heights of the blocks in the
+// [from, to] range holding logs which match the address and topic criteria.
+// The boolean result is false if the logs of some blocks of the range have not
+// been indexed, or if the range is larger than MaxLogBlockRange.
+func (kv *KVIndexer) GetLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error) {
+	if to-from+1 > MaxLogBlockRange {
+		return nil, false, nil
+	}
+	indexed, err := kv.countKeys(LogBlockKey(from), LogBlockKey(to+1))
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetLogBlocks %d %d", from, to)
+	}
+	if int64(indexed) != to-from+1 {
+		return nil, false, nil
+	}
+
+	// each clause holds the key prefixes of the alternatives of an address or
+	// topic criteria, a block must match all the clauses
+	var clauses [][][]byte
+	if len(addresses) > 0 {
+		clause := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			clause[i] = append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+		}
+		clauses = append(clauses, clause)
+	}
+	for pos, topicList := range topics {
+		// an empty list matches any topic
+		if len(topicList) == 0 {
+			continue
+		}
+		clause := make([][]byte, len(topicList))
+		for i, topic := range topicList {
+			clause[i] = append([]byte{KeyPrefixLogTopic, byte(pos)}, topic.Bytes()...)
+		}
+		clauses = append(clauses, clause)
+	}
+
+	if len(clauses) == 0 {
+		heights, err := kv.txLogsHeights(from, to)
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "GetLogBlocks %d %d", from, to)
+		}
+		return heights, true, nil
+	}
+
+	var heights []int64
+	for i, clause := range clauses {
+		matches := make(map[int64]struct{})
+		for _, prefix := range clause {
+			if err := kv.collectHeights(prefix, from, to, matches); err != nil {
+				return nil, false, errorsmod.Wrapf(err, "GetLogBlocks %d %d", from, to)
+			}
+		}
+		if i == 0 {
+			for height := range matches {
+				heights = append(heights, height)
+			}
+			continue
+		}
+		heights = slices.DeleteFunc(heights, func(height int64) bool {
+			_, ok := matches[height]
+			return !ok
+		})
+	}
+	slices.Sort(heights)
+	return heights, true, nil
+}
+
+// countKeys returns the number of keys in the [start, end) range.
+func (kv *KVIndexer) countKeys(start, end []byte) (int, error) {
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	count := 0
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// txLogsHeights returns the heights of the blocks in the [from, to] range
+// holding logs.
+func (kv *KVIndexer) txLogsHeights(from, to int64) ([]int64, error) {
+	it, err := kv.db.Iterator(TxLogsKey(from, 0), TxLogsKey(to+1, 0))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	heights := []int64{}
+	for ; it.Valid(); it.Next() {
+		height := int64(sdk.BigEndianToUint64(it.Key()[1:9])) //#nosec G115 -- int overflow is not a concern here
+		if len(heights) == 0 || heights[len(heights)-1] != height {
+			heights = append(heights, height)
+		}
+	}
+	return heights, nil
+}
+
+// collectHeights adds to matches the heights in the [from, to] range indexed
+// under the given address or topic key prefix.
+func (kv *KVIndexer) collectHeights(prefix []byte, from, to int64, matches map[int64]struct{}) error {
+	it, err := kv.db.Iterator(logFilterKey(prefix, from), logFilterKey(prefix, to+1))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		matches[int64(sdk.BigEndianToUint64(key[len(prefix):]))] = struct{}{} //#nosec G115 -- int overflow is not a concern here
+	}
+	return nil
+}
+
+// LogBlockKey returns the key for db entry: `block number -> logs indexed marker`
+func LogBlockKey(blockNumber int64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append([]byte{KeyPrefixLogBlock}, bz...)
+}
+
+// TxLogsKey returns the key for db entry: `(block number, tx index) -> tx logs`
+func TxLogsKey(blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(append([]byte{KeyPrefixTxLogs}, bz1...), bz2...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number) -> marker`
+func LogAddressKey(address common.Address, blockNumber int64) []byte {
+	return logFilterKey(append([]byte{KeyPrefixLogAddress}, address.Bytes()...), blockNumber)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number) -> marker`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64) []byte {
+	return logFilterKey(append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...), blockNumber)
+}
+
+func logFilterKey(prefix []byte, blockNumber int64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append(slices.Clone(prefix), bz...)
+}
+
+// saveTxLogs index the logs of the eth tx, along with their addresses and
+// topics, into the kv db batch
+func saveTxLogs(batch dbm.Batch, txHash common.Hash, txResult *servertypes.TxResult, logs []*ethtypes.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	txLogs := evmtypes.NewTransactionLogsFromEth(txHash, logs)
+	bz, err := evmtypes.EncodeTransactionLogs(&txLogs)
+	if err != nil {
+		return errorsmod.Wrap(err, "encode tx logs")
+	}
+	if err := batch.Set(TxLogsKey(txResult.Height, txResult.EthTxIndex), bz); err != nil {
+		return errorsmod.Wrap(err, "set tx-logs key")
+	}
+	for _, log := range logs {
+		if err := batch.Set(LogAddressKey(log.Address, txResult.Height), []byte{1}); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+		for pos, topic := range log.Topics {
+			if err := batch.Set(LogTopicKey(pos, topic, txResult.Height), []byte{1}); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	return nil
+}
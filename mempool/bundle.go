@@ -0,0 +1,561 @@
+package mempool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/utils"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxBundleTxs is the maximum number of txs of a bundle.
+	MaxBundleTxs = 16
+	// maxPooledBundles is the maximum number of bundles held by the mempool.
+	maxPooledBundles = 256
+	// maxBundleSimulations is the maximum number of bundles simulated when a
+	// block is built.
+	maxBundleSimulations = 32
+)
+
+// Bundle is a set of EVM txs, possibly from different senders, which are
+// included contiguously and all-or-nothing in a block.
+//
+// NOTE: the all-or-nothing inclusion only holds for the state the bundle is
+// simulated on, that is the latest state with the bundles selected before it
+// on top. The txs placed ahead of the bundle in the block by the iterator, and
+// the cosmos txs, are not part of the simulation, so a member may still fail
+// when the block is executed.
+type Bundle struct {
+	// Txs are the txs of the bundle, in execution order.
+	Txs []*ethtypes.Transaction
+	// BlockNumber is the height of the block the bundle targets.
+	BlockNumber uint64
+	// MinTimestamp and MaxTimestamp bound the time of the block the bundle is
+	// included in, in unix seconds. Zero leaves the bound unset.
+	MinTimestamp uint64
+	MaxTimestamp uint64
+}
+
+// Hash returns the hash of the bundle, which is the keccak256 hash of the
+// concatenated hashes of its txs.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// validFor returns true if the bundle can be included in the block at height
+// and time.
+func (b *Bundle) validFor(height, time uint64) bool {
+	if b.BlockNumber != height {
+		return false
+	}
+	if b.MinTimestamp != 0 && time < b.MinTimestamp {
+		return false
+	}
+	return b.MaxTimestamp == 0 || time <= b.MaxTimestamp
+}
+
+// pooledBundle is a bundle held by the bundle pool, along with the sdk txs of
+// its members.
+type pooledBundle struct {
+	hash    common.Hash
+	bundle  *Bundle
+	txs     []sdk.Tx
+	txBytes [][]byte
+}
+
+// selectedBundle is a bundle selected for a block, along with the gas weighted
+// effective tip of its members at the base fee of the block.
+type selectedBundle struct {
+	hash   common.Hash
+	txs    []sdk.Tx
+	ethTxs []*ethtypes.Transaction
+	tip    *uint256.Int
+}
+
+// bundleMember locates a tx in the bundle pool.
+type bundleMember struct {
+	hash  common.Hash
+	index int
+	size  int
+}
+
+// bundlePool holds the bundles submitted to the mempool until their target
+// block is committed. The members of the bundles are simulated on insertion
+// and again when their target block is built.
+type bundlePool struct {
+	mu sync.Mutex
+
+	bundles map[common.Hash]*pooledBundle
+	// members indexes the bundle members by the hash of their sdk encoding
+	members map[string]bundleMember
+	// selected indexes the members of the bundles selected for the block
+	// being built
+	selected map[string]bundleMember
+
+	// pooled reports whether the tx, or another tx of its sender with the
+	// same nonce, is held by the EVM pool
+	pooled func(tx *ethtypes.Transaction) bool
+
+	rechecker  legacypool.Rechecker
+	blockchain *Blockchain
+	encoder    *TxEncoder
+	logger     log.Logger
+}
+
+// newBundlePool creates a bundle pool. It returns nil if the rechecker is
+// nil, which disables bundles. The bundles whose members are reported as
+// pooled by pooled are rejected, if set.
+func newBundlePool(
+	rechecker legacypool.Rechecker,
+	pooled func(tx *ethtypes.Transaction) bool,
+	blockchain *Blockchain,
+	encoder *TxEncoder,
+	logger log.Logger,
+) *bundlePool {
+	if rechecker == nil {
+		return nil
+	}
+	return &bundlePool{
+		bundles:    make(map[common.Hash]*pooledBundle),
+		members:    make(map[string]bundleMember),
+		selected:   make(map[string]bundleMember),
+		pooled:     pooled,
+		rechecker:  rechecker,
+		blockchain: blockchain,
+		encoder:    encoder,
+		logger:     logger.With("pool", "bundles"),
+	}
+}
+
+// add simulates the bundle on top of the latest state and adds it to the pool.
+func (p *bundlePool) add(bundle *Bundle) (common.Hash, error) {
+	if len(bundle.Txs) == 0 {
+		return common.Hash{}, ErrBundleEmpty
+	}
+	if len(bundle.Txs) > MaxBundleTxs {
+		return common.Hash{}, fmt.Errorf("%w: %d > %d", ErrBundleTooLarge, len(bundle.Txs), MaxBundleTxs)
+	}
+	if bundle.MinTimestamp != 0 && bundle.MaxTimestamp != 0 && bundle.MinTimestamp > bundle.MaxTimestamp {
+		return common.Hash{}, ErrBundleTimestamps
+	}
+	head := p.blockchain.CurrentBlock().Number.Uint64()
+	if bundle.BlockNumber <= head {
+		return common.Hash{}, fmt.Errorf("%w: target %d, latest %d", ErrBundleBlockPassed, bundle.BlockNumber, head)
+	}
+	if p.pooled != nil {
+		// the pooled txs are returned by the iterator on their own, so they
+		// can't be included as a unit
+		for _, tx := range bundle.Txs {
+			if p.pooled(tx) {
+				return common.Hash{}, fmt.Errorf("%w: %s", ErrBundleTxPooled, tx.Hash())
+			}
+		}
+	}
+
+	pooled := &pooledBundle{
+		hash:    bundle.Hash(),
+		bundle:  bundle,
+		txs:     make([]sdk.Tx, len(bundle.Txs)),
+		txBytes: make([][]byte, len(bundle.Txs)),
+	}
+	for i, tx := range bundle.Txs {
+		cosmosTx, err := p.encoder.EVMTxToCosmosTx(tx)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("converting bundle tx %s: %w", tx.Hash(), err)
+		}
+		bz, err := p.encoder.CosmosTx(cosmosTx)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("encoding bundle tx %s: %w", tx.Hash(), err)
+		}
+		pooled.txs[i], pooled.txBytes[i] = cosmosTx, bz
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.bundles[pooled.hash]; ok {
+		return common.Hash{}, ErrBundleKnown
+	}
+	if len(p.bundles) >= maxPooledBundles {
+		return common.Hash{}, ErrBundlePoolFull
+	}
+	for i, bz := range pooled.txBytes {
+		if _, ok := p.members[memberKey(bz)]; ok {
+			return common.Hash{}, fmt.Errorf("%w: %s", ErrBundleTxConflict, bundle.Txs[i].Hash())
+		}
+	}
+	if err := p.simulate(bundle.Txs, nil); err != nil {
+		return common.Hash{}, err
+	}
+
+	p.bundles[pooled.hash] = pooled
+	for i, bz := range pooled.txBytes {
+		p.members[memberKey(bz)] = bundleMember{hash: pooled.hash, index: i, size: len(pooled.txBytes)}
+	}
+	return pooled.hash, nil
+}
+
+// simulate runs the txs in order through the rechecker and executes them, the
+// way eth_callBundle does, in a block simulated on top of the latest state
+// with the overrides. It fails if a tx fails the ante handler or its
+// execution fails or reverts. The state changes of the simulation are
+// discarded.
+//
+// NOTE: the caller must hold the pool lock, since the rechecker is not thread
+// safe.
+func (p *bundlePool) simulate(txs []*ethtypes.Transaction, overrides *rpctypes.BlockOverrides) error {
+	if err := simulateTxs(p.rechecker, p.blockchain, txs); err != nil {
+		return err
+	}
+	return executeTxs(p.blockchain, txs, overrides)
+}
+
+// executeTxs executes the txs in order, with their nonces and fees validated,
+// in a single block simulated on top of the latest state through the
+// SimulateV1 query of eth_callBundle. It fails if a tx can't be executed or
+// its receipt is failed.
+func executeTxs(blockchain *Blockchain, txs []*ethtypes.Transaction, overrides *rpctypes.BlockOverrides) error {
+	ctx, err := blockchain.GetLatestContext()
+	if err != nil {
+		return fmt.Errorf("fetching latest context: %w", err)
+	}
+
+	calls := make([]vmtypes.TransactionArgs, len(txs))
+	for i, tx := range txs {
+		from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return fmt.Errorf("tx %s: %w", tx.Hash(), err)
+		}
+		calls[i] = rpctypes.BundleCallArgs(tx, from)
+	}
+	opts, err := json.Marshal(&rpctypes.SimOpts{
+		BlockStateCalls: []rpctypes.SimBlock{{BlockOverrides: overrides, Calls: calls}},
+		Validation:      true,
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := blockchain.vmKeeper.SimulateV1(ctx, &vmtypes.SimulateV1Request{
+		Opts:   opts,
+		GasCap: blockchain.blockGasLimit,
+	})
+	if err != nil {
+		return fmt.Errorf("executing txs: %w", err)
+	}
+	if res.Error != "" {
+		return fmt.Errorf("executing txs: %s", res.Error)
+	}
+	var blocks []*rpctypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &blocks); err != nil {
+		return fmt.Errorf("decoding execution result: %w", err)
+	}
+	// the gap up to an overridden block number is filled with empty blocks
+	if len(blocks) == 0 || len(blocks[len(blocks)-1].Calls) != len(txs) {
+		return errors.New("unexpected number of executed txs")
+	}
+	for i, call := range blocks[len(blocks)-1].Calls {
+		if call.Error != nil {
+			return fmt.Errorf("tx %s failed execution: %s", txs[i].Hash(), call.Error.Message)
+		}
+		if call.Status != hexutil.Uint64(ethtypes.ReceiptStatusSuccessful) {
+			return fmt.Errorf("tx %s failed execution", txs[i].Hash())
+		}
+	}
+	return nil
+}
+
+// simulateTxs runs the txs in order through the rechecker on top of the latest
+// state. The state changes of the simulation are discarded.
+func simulateTxs(rechecker legacypool.Rechecker, blockchain *Blockchain, txs []*ethtypes.Transaction) error {
+	_, err := simulatePrefix(rechecker, blockchain, txs)
+	return err
+}
+
+// simulatePrefix runs the txs in order through the rechecker on top of the
+// latest state, and returns the number of txs which passed the simulation
+// before the first failing one. The state changes of the simulation are
+// discarded.
+func simulatePrefix(rechecker legacypool.Rechecker, blockchain *Blockchain, txs []*ethtypes.Transaction) (int, error) {
+	ctx, err := blockchain.GetLatestContext()
+	if err != nil {
+		return 0, fmt.Errorf("fetching latest context: %w", err)
+	}
+	rechecker.Update(ctx, blockchain.CurrentBlock())
+
+	ctx, _ = rechecker.GetContext()
+	for i, tx := range txs {
+		if ctx, err = rechecker.RecheckEVM(ctx, tx); err != nil {
+			return i, fmt.Errorf("tx %s failed simulation: %w", tx.Hash(), err)
+		}
+	}
+	return len(txs), nil
+}
+
+// remove drops the bundle from the pool.
+//
+// NOTE: the caller must hold the pool lock.
+func (p *bundlePool) remove(pooled *pooledBundle) {
+	delete(p.bundles, pooled.hash)
+	for _, bz := range pooled.txBytes {
+		delete(p.members, memberKey(bz))
+		delete(p.selected, memberKey(bz))
+	}
+}
+
+// prune drops the bundles targeting a committed block.
+func (p *bundlePool) prune(head *ethtypes.Header) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, pooled := range p.bundles {
+		if pooled.bundle.BlockNumber <= head.Number.Uint64() {
+			p.remove(pooled)
+		}
+	}
+}
+
+// selectFor returns the bundles which can be included in the block at height
+// and time, sorted by their gas weighted effective tip at the base fee. In tip
+// order, each bundle is simulated on top of the latest state and the bundles
+// selected before it, and it is only selected if all its members succeed. The
+// bundles which fail on top of the latest state alone are dropped. At most
+// maxBundleSimulations bundles are simulated, and the lower tip bundles left
+// over are not selected for this block.
+func (p *bundlePool) selectFor(height, time uint64, baseFee *big.Int) []selectedBundle {
+	overrides := &rpctypes.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).SetUint64(height))}
+	if time > p.blockchain.CurrentBlock().Time {
+		overrides.Time = (*hexutil.Uint64)(&time)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	type candidate struct {
+		pooled *pooledBundle
+		tip    *uint256.Int
+	}
+	var candidates []candidate
+	for _, pooled := range p.bundles {
+		if !pooled.bundle.validFor(height, time) {
+			continue
+		}
+		tip, ok := bundleTip(pooled.bundle, baseFee)
+		if !ok {
+			// the bundle can't pay the base fee of this block
+			continue
+		}
+		candidates = append(candidates, candidate{pooled: pooled, tip: tip})
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return b.tip.Cmp(a.tip)
+	})
+
+	var (
+		selected []selectedBundle
+		// prefix are the members of the selected bundles, in block order
+		prefix []*ethtypes.Transaction
+	)
+	clear(p.selected)
+	for i, c := range candidates {
+		if i >= maxBundleSimulations {
+			break
+		}
+		pooled := c.pooled
+		if err := p.simulate(slices.Concat(prefix, pooled.bundle.Txs), overrides); err != nil {
+			if len(prefix) == 0 {
+				p.logger.Debug("dropping bundle", "bundle_hash", pooled.hash, "err", err)
+				p.remove(pooled)
+			} else {
+				// the bundle may only conflict with the higher tip ones
+				p.logger.Debug("skipping bundle", "bundle_hash", pooled.hash, "err", err)
+			}
+			continue
+		}
+		prefix = append(prefix, pooled.bundle.Txs...)
+		selected = append(selected, selectedBundle{hash: pooled.hash, txs: pooled.txs, ethTxs: pooled.bundle.Txs, tip: c.tip})
+		for i, bz := range pooled.txBytes {
+			p.selected[memberKey(bz)] = bundleMember{hash: pooled.hash, index: i, size: len(pooled.txBytes)}
+		}
+	}
+	return selected
+}
+
+// member returns the bundle member of the sdk encoded tx, if the tx is a
+// member of a bundle selected for the block being built.
+func (p *bundlePool) member(txBz []byte) (bundleMember, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.selected) == 0 {
+		return bundleMember{}, false
+	}
+	m, ok := p.selected[memberKey(txBz)]
+	return m, ok
+}
+func memberKey(txBz []byte) string {
+	return string(cmttypes.Tx(txBz).Hash())
+}
+
+// bundleTip returns the gas weighted effective tip of the bundle txs at the
+// base fee. It returns false if a tx can't pay the base fee.
+func bundleTip(bundle *Bundle, baseFee *big.Int) (*uint256.Int, bool) {
+	var (
+		weighted = new(big.Int)
+		gas      = new(big.Int)
+	)
+	for _, tx := range bundle.Txs {
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil {
+			return nil, false
+		}
+		txGas := new(big.Int).SetUint64(tx.Gas())
+		weighted.Add(weighted, tip.Mul(tip, txGas))
+		gas.Add(gas, txGas)
+	}
+	if gas.Sign() == 0 {
+		return uint256.NewInt(0), true
+	}
+	tip, overflow := uint256.FromBig(weighted.Quo(weighted, gas))
+	if overflow {
+		return nil, false
+	}
+	return tip, true
+}
+
+// legacyPooled returns whether the tx, or another tx of its sender with the
+// same nonce, is held by the legacy pool.
+func legacyPooled(pool *legacypool.LegacyPool) func(tx *ethtypes.Transaction) bool {
+	return func(tx *ethtypes.Transaction) bool {
+		if pool.Has(tx.Hash()) {
+			return true
+		}
+		from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return false
+		}
+		pending, queued := pool.ContentFrom(from)
+		return slices.ContainsFunc(slices.Concat(pending, queued), func(pooled *ethtypes.Transaction) bool {
+			return pooled.Nonce() == tx.Nonce()
+		})
+	}
+}
+
+// legacyPending returns the pending txs of the sender held by the legacy pool.
+func legacyPending(pool *legacypool.LegacyPool) func(from common.Address) []*ethtypes.Transaction {
+	return func(from common.Address) []*ethtypes.Transaction {
+		pending, _ := pool.ContentFrom(from)
+		return pending
+	}
+}
+
+// withoutBundleTxs drops the pending EVM txs which share the sender and nonce
+// of a member of the selected bundles, so that the iterator returns neither a
+// member twice nor two txs with the same nonce. The later txs of the sender
+// are dropped as well, since they depend on the nonce of the dropped tx.
+func withoutBundleTxs(
+	pending map[common.Address][]*txpool.LazyTransaction,
+	bundles []selectedBundle,
+) map[common.Address][]*txpool.LazyTransaction {
+	nonces := make(map[common.Address]map[uint64]struct{})
+	for _, bundle := range bundles {
+		for _, tx := range bundle.ethTxs {
+			from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+			if err != nil {
+				continue
+			}
+			if nonces[from] == nil {
+				nonces[from] = make(map[uint64]struct{})
+			}
+			nonces[from][tx.Nonce()] = struct{}{}
+		}
+	}
+	if len(nonces) == 0 {
+		return pending
+	}
+
+	filtered := make(map[common.Address][]*txpool.LazyTransaction, len(pending))
+	for from, txs := range pending {
+		if bundled, ok := nonces[from]; ok {
+			for i, ltx := range txs {
+				if _, ok := bundled[ltx.Tx.Nonce()]; ok {
+					txs = txs[:i]
+					break
+				}
+			}
+		}
+		if len(txs) > 0 {
+			filtered[from] = txs
+		}
+	}
+	return filtered
+}
+
+// InsertBundle simulates the bundle on top of the latest state and adds it to
+// the mempool until its target block is committed. The members are run
+// through the ante handler and executed like eth_callBundle does, and the
+// bundle is rejected if one of them fails or reverts. The bundle is returned
+// as a unit by the iterator of its target block, and dropped if one of its
+// members fails the simulation when the block is built.
+func (m *Mempool) InsertBundle(bundle *Bundle) (common.Hash, error) {
+	if m.bundles == nil {
+		return common.Hash{}, ErrBundlesDisabled
+	}
+	return m.bundles.add(bundle)
+}
+
+// selectBundles returns the bundles and the private txs which can be included
+// in the block being built with ctx, sorted by tip.
+func (m *Mempool) selectBundles(ctx context.Context) []selectedBundle {
+	if m.bundles == nil && m.privateTxs == nil {
+		return nil
+	}
+
+	sdkctx := sdk.UnwrapSDKContext(ctx)
+	height, err := utils.SafeUint64(sdkctx.BlockHeight())
+	if err != nil {
+		return nil
+	}
+	time, err := utils.SafeUint64(sdkctx.BlockTime().Unix())
+	if err != nil {
+		return nil
+	}
+	baseFee := m.vmKeeper.GetBaseFee(sdkctx)
+
+	var selected []selectedBundle
+	if m.bundles != nil {
+		selected = append(selected, m.bundles.selectFor(height, time, baseFee)...)
+	}
+	if m.privateTxs != nil {
+		selected = append(selected, m.privateTxs.selectFor(height, baseFee)...)
+	}
+	slices.SortStableFunc(selected, func(a, b selectedBundle) int {
+		return b.tip.Cmp(a.tip)
+	})
+	return selected
+}
@@ -0,0 +1,213 @@
+package mempool
+
+import (
+	"context"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ baseapp.TxSelector = &bundleTxSelector{}
+
+// bundleTxSelector selects the txs of the proposals built from the mempool
+// like the default selector of the sdk, but includes the bundles atomically.
+// The members of a bundle are selected tentatively and committed once its last
+// member is selected. The bundle is rolled back if one of its members is
+// skipped by the proposal handler or doesn't fit in the block, and the later
+// txs of its senders are not selected, since the proposal handler accounted
+// for the nonces of the rolled back members.
+type bundleTxSelector struct {
+	bundles         *bundlePool
+	signerExtractor sdkmempool.SignerExtractionAdapter
+	// onSelect is called when a tx is selected, before the proposal handler
+	// moves to the next tx
+	onSelect func()
+
+	selectedTxs  [][]byte
+	totalTxBytes uint64
+	totalTxGas   uint64
+
+	// bundle is the bundle being selected, if any, and next the index of its
+	// next member
+	bundle *bundleMember
+	next   int
+	// start, startTxBytes and startTxGas restore the selection on rollback
+	start        int
+	startTxBytes uint64
+	startTxGas   uint64
+	// senders are the senders of the selected members of the bundle
+	senders []string
+	// excluded are the senders of the rolled back bundles
+	excluded map[string]struct{}
+
+	// selecting is set by SelectTxForProposal until the following call to
+	// SelectedTxs. The proposal handler calls SelectedTxs after each selected
+	// tx to account for the nonces of its senders, so a call to SelectedTxs
+	// without a preceding selection returns the final txs of the proposal.
+	selecting bool
+}
+
+// NewTxSelector returns the tx selector of the proposal handler consuming the
+// mempool, which includes the bundles of the mempool as a unit.
+func (m *Mempool) NewTxSelector() baseapp.TxSelector {
+	return &bundleTxSelector{
+		bundles:         m.bundles,
+		signerExtractor: m.signerExtractor,
+		onSelect:        m.laneTxSelected,
+		excluded:        make(map[string]struct{}),
+	}
+}
+
+// SelectedTxs returns the selected txs. The final call drops the members of
+// an incomplete bundle.
+func (s *bundleTxSelector) SelectedTxs(_ context.Context) [][]byte {
+	if !s.selecting {
+		s.rollback()
+	}
+	s.selecting = false
+
+	txs := make([][]byte, len(s.selectedTxs))
+	copy(txs, s.selectedTxs)
+	return txs
+}
+
+// Clear clears the selection.
+func (s *bundleTxSelector) Clear() {
+	s.selectedTxs = nil
+	s.totalTxBytes = 0
+	s.totalTxGas = 0
+	s.bundle = nil
+	s.next = 0
+	s.senders = nil
+	s.excluded = make(map[string]struct{})
+	s.selecting = false
+}
+
+// SelectTxForProposal selects the tx if it fits in the block, and returns true
+// once the block is full. It never stops the selection within a bundle.
+func (s *bundleTxSelector) SelectTxForProposal(_ context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	s.selecting = true
+
+	member, isMember := s.member(txBz)
+	senders := s.txSenders(memTx)
+
+	rolledBack := false
+	if s.bundle != nil && (!isMember || member.hash != s.bundle.hash || member.index != s.next) {
+		// a member of the bundle was skipped
+		rolledBack = s.rollback()
+	}
+	skip := func() bool {
+		// the proposal handler accounts for the nonces of the tx if the
+		// selection changed, so its senders are excluded like the ones of the
+		// rolled back bundle
+		if rolledBack {
+			s.exclude(senders)
+		}
+		return s.full(maxTxBytes, maxBlockGas)
+	}
+
+	if s.isExcluded(senders) || (isMember && s.bundle == nil && member.index != 0) {
+		return skip()
+	}
+
+	txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+	var txGas uint64
+	if gasTx, ok := memTx.(baseapp.GasTx); ok {
+		txGas = gasTx.GetGas()
+	}
+	if s.totalTxBytes+txSize > maxTxBytes || (maxBlockGas > 0 && s.totalTxGas+txGas > maxBlockGas) {
+		if s.bundle != nil {
+			rolledBack = s.rollback()
+		}
+		return skip()
+	}
+
+	if isMember && s.bundle == nil {
+		s.bundle = &member
+		s.next = 0
+		s.start = len(s.selectedTxs)
+		s.startTxBytes, s.startTxGas = s.totalTxBytes, s.totalTxGas
+	}
+	s.selectedTxs = append(s.selectedTxs, txBz)
+	s.totalTxBytes += txSize
+	s.totalTxGas += txGas
+	if s.onSelect != nil {
+		s.onSelect()
+	}
+
+	if s.bundle != nil {
+		s.senders = append(s.senders, senders...)
+		s.next++
+		if s.next < s.bundle.size {
+			return false
+		}
+		// the last member of the bundle was selected
+		s.bundle = nil
+		s.senders = nil
+	}
+	return s.full(maxTxBytes, maxBlockGas)
+}
+
+// rollback drops the selected members of the bundle being selected and
+// excludes their senders. It returns false if no bundle is being selected.
+func (s *bundleTxSelector) rollback() bool {
+	if s.bundle == nil {
+		return false
+	}
+	s.selectedTxs = s.selectedTxs[:s.start]
+	s.totalTxBytes, s.totalTxGas = s.startTxBytes, s.startTxGas
+	s.exclude(s.senders)
+	s.bundle = nil
+	s.senders = nil
+	return true
+}
+
+func (s *bundleTxSelector) full(maxTxBytes, maxBlockGas uint64) bool {
+	return s.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && s.totalTxGas >= maxBlockGas)
+}
+
+func (s *bundleTxSelector) member(txBz []byte) (bundleMember, bool) {
+	if s.bundles == nil {
+		return bundleMember{}, false
+	}
+	return s.bundles.member(txBz)
+}
+
+func (s *bundleTxSelector) txSenders(tx sdk.Tx) []string {
+	return txSenders(s.signerExtractor, tx)
+}
+
+// txSenders returns the signers of the tx as strings, or nil if they can't be
+// extracted.
+func txSenders(signerExtractor sdkmempool.SignerExtractionAdapter, tx sdk.Tx) []string {
+	if tx == nil {
+		return nil
+	}
+	signers, err := signerExtractor.GetSigners(tx)
+	if err != nil {
+		return nil
+	}
+	senders := make([]string, len(signers))
+	for i, signer := range signers {
+		senders[i] = signer.Signer.String()
+	}
+	return senders
+}
+
+func (s *bundleTxSelector) exclude(senders []string) {
+	for _, sender := range senders {
+		s.excluded[sender] = struct{}{}
+	}
+}
+
+func (s *bundleTxSelector) isExcluded(senders []string) bool {
+	for _, sender := range senders {
+		if _, ok := s.excluded[sender]; ok {
+			return true
+		}
+	}
+	return false
+}
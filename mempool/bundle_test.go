@@ -0,0 +1,541 @@
+package mempool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/mempool/txpool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/testutil/constants"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// testBundleRechecker fails the simulation of the txs marked as failing.
+type testBundleRechecker struct {
+	failing map[common.Hash]bool
+}
+
+func (r *testBundleRechecker) GetContext() (sdk.Context, func()) { return sdk.Context{}, func() {} }
+
+func (r *testBundleRechecker) RecheckEVM(ctx sdk.Context, tx *ethtypes.Transaction) (sdk.Context, error) {
+	if r.failing[tx.Hash()] {
+		return ctx, errors.New("simulation failed")
+	}
+	return ctx, nil
+}
+
+func (r *testBundleRechecker) Update(sdk.Context, *ethtypes.Header) {}
+
+// simulatedCall identifies a simulated call by its sender and nonce.
+type simulatedCall struct {
+	from  common.Address
+	nonce uint64
+}
+
+func simulatedCallOf(t *testing.T, tx *ethtypes.Transaction) simulatedCall {
+	t.Helper()
+	from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	require.NoError(t, err)
+	return simulatedCall{from: from, nonce: tx.Nonce()}
+}
+
+// testBundleKeeper executes the simulated blocks of the bundle pool. The calls
+// marked as reverting revert, and so do the calls executed after the call
+// they conflict with.
+type testBundleKeeper struct {
+	VMKeeperI
+
+	reverting map[simulatedCall]bool
+	conflicts map[simulatedCall]simulatedCall
+	// blocks are the executed blocks
+	blocks []rpctypes.SimBlock
+}
+
+func (k *testBundleKeeper) SimulateV1(_ context.Context, req *vmtypes.SimulateV1Request) (*vmtypes.SimulateV1Response, error) {
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, err
+	}
+	block := opts.BlockStateCalls[0]
+	k.blocks = append(k.blocks, block)
+
+	var (
+		result   = &rpctypes.SimBlockResult{Calls: make([]rpctypes.SimCallResult, len(block.Calls))}
+		executed = make(map[simulatedCall]bool)
+	)
+	for i, args := range block.Calls {
+		call := simulatedCall{from: *args.From, nonce: uint64(*args.Nonce)}
+		conflict, ok := k.conflicts[call]
+		if k.reverting[call] || (ok && executed[conflict]) {
+			result.Calls[i].Error = &rpctypes.SimCallError{Message: vm.ErrExecutionReverted.Error(), Code: rpctypes.ErrCodeVMError}
+		} else {
+			result.Calls[i].Status = hexutil.Uint64(ethtypes.ReceiptStatusSuccessful)
+		}
+		executed[call] = true
+	}
+	data, err := json.Marshal([]*rpctypes.SimBlockResult{result})
+	if err != nil {
+		return nil, err
+	}
+	return &vmtypes.SimulateV1Response{Data: data}, nil
+}
+
+func setupBundlePool(t *testing.T) (*bundlePool, *testBundleRechecker, *testBundleKeeper) {
+	t.Helper()
+	txConfig, b := setupIteratorTest(t)
+	b.latestCtx = sdk.Context{}.WithContext(context.Background())
+
+	keeper := &testBundleKeeper{
+		reverting: make(map[simulatedCall]bool),
+		conflicts: make(map[simulatedCall]simulatedCall),
+	}
+	b.vmKeeper = keeper
+	rechecker := &testBundleRechecker{failing: make(map[common.Hash]bool)}
+	return newBundlePool(rechecker, nil, b, NewTxEncoder(txConfig), log.NewNopLogger()), rechecker, keeper
+}
+
+func bundleTx(t *testing.T, key *ethsecp256k1.PrivKey, nonce uint64, tip int64) *ethtypes.Transaction {
+	t.Helper()
+	return buildEVMTx(t, key, nonce, big.NewInt(1_000_000_000_000), big.NewInt(tip), big.NewInt(constants.EighteenDecimalsChainID)).Tx
+}
+
+func TestBundlePool_Add(t *testing.T) {
+	pool, rechecker, keeper := setupBundlePool(t)
+	_, key := newAddrKey(t)
+
+	tx0 := bundleTx(t, key, 0, 2_000_000_000)
+	tx1 := bundleTx(t, key, 1, 2_000_000_000)
+
+	_, err := pool.add(&Bundle{BlockNumber: 1})
+	require.ErrorIs(t, err, ErrBundleEmpty)
+
+	txs := make([]*ethtypes.Transaction, MaxBundleTxs+1)
+	for i := range txs {
+		txs[i] = tx0
+	}
+	_, err = pool.add(&Bundle{Txs: txs, BlockNumber: 1})
+	require.ErrorIs(t, err, ErrBundleTooLarge)
+
+	_, err = pool.add(&Bundle{Txs: []*ethtypes.Transaction{tx0}, BlockNumber: 1, MinTimestamp: 2, MaxTimestamp: 1})
+	require.ErrorIs(t, err, ErrBundleTimestamps)
+
+	// the latest block is the genesis block
+	_, err = pool.add(&Bundle{Txs: []*ethtypes.Transaction{tx0}, BlockNumber: 0})
+	require.ErrorIs(t, err, ErrBundleBlockPassed)
+
+	rechecker.failing[tx1.Hash()] = true
+	_, err = pool.add(&Bundle{Txs: []*ethtypes.Transaction{tx0, tx1}, BlockNumber: 1})
+	require.ErrorContains(t, err, "simulation failed")
+	delete(rechecker.failing, tx1.Hash())
+
+	// a bundle with a reverting member is rejected
+	keeper.reverting[simulatedCallOf(t, tx1)] = true
+	_, err = pool.add(&Bundle{Txs: []*ethtypes.Transaction{tx0, tx1}, BlockNumber: 1})
+	require.ErrorContains(t, err, "execution reverted")
+	require.Empty(t, pool.bundles)
+	delete(keeper.reverting, simulatedCallOf(t, tx1))
+
+	bundle := &Bundle{Txs: []*ethtypes.Transaction{tx0, tx1}, BlockNumber: 1}
+	hash, err := pool.add(bundle)
+	require.NoError(t, err)
+	require.Equal(t, bundle.Hash(), hash)
+
+	_, err = pool.add(bundle)
+	require.ErrorIs(t, err, ErrBundleKnown)
+
+	// a tx can't be a member of two bundles
+	_, err = pool.add(&Bundle{Txs: []*ethtypes.Transaction{tx1}, BlockNumber: 2})
+	require.ErrorIs(t, err, ErrBundleTxConflict)
+
+	// a tx conflicting with the EVM pool can't be a member of a bundle
+	pooledTx := bundleTx(t, key, 2, 2_000_000_000)
+	pool.pooled = func(tx *ethtypes.Transaction) bool { return tx.Hash() == pooledTx.Hash() }
+	_, err = pool.add(&Bundle{Txs: []*ethtypes.Transaction{pooledTx}, BlockNumber: 2})
+	require.ErrorIs(t, err, ErrBundleTxPooled)
+
+	// the members are only known to the selector once their bundle is
+	// selected for the block being built
+	bz, err := pool.encoder.EVMTx(tx1)
+	require.NoError(t, err)
+	_, ok := pool.member(bz)
+	require.False(t, ok)
+	require.Len(t, pool.selectFor(1, 0, nil), 1)
+	member, ok := pool.member(bz)
+	require.True(t, ok)
+	require.Equal(t, bundleMember{hash: hash, index: 1, size: 2}, member)
+	require.Empty(t, pool.selectFor(2, 0, nil))
+	_, ok = pool.member(bz)
+	require.False(t, ok)
+}
+
+func TestBundlePool_SelectFor(t *testing.T) {
+	pool, rechecker, keeper := setupBundlePool(t)
+	baseFee := big.NewInt(1_000_000_000)
+
+	var (
+		keys    = make([]*ethsecp256k1.PrivKey, 4)
+		bundles = make([]*Bundle, 4)
+	)
+	for i := range keys {
+		_, keys[i] = newAddrKey(t)
+	}
+	// the bundle tips are weighted by the gas of their txs
+	bundles[0] = &Bundle{Txs: []*ethtypes.Transaction{bundleTx(t, keys[0], 0, 1_000_000_000)}, BlockNumber: 1}
+	bundles[1] = &Bundle{Txs: []*ethtypes.Transaction{bundleTx(t, keys[1], 0, 1_000_000_000), bundleTx(t, keys[1], 1, 5_000_000_000)}, BlockNumber: 1}
+	// the bundles targeting another block or time are not selected
+	bundles[2] = &Bundle{Txs: []*ethtypes.Transaction{bundleTx(t, keys[2], 0, 9_000_000_000)}, BlockNumber: 2}
+	bundles[3] = &Bundle{Txs: []*ethtypes.Transaction{bundleTx(t, keys[3], 0, 9_000_000_000)}, BlockNumber: 1, MinTimestamp: 100, MaxTimestamp: 200}
+	for _, bundle := range bundles {
+		_, err := pool.add(bundle)
+		require.NoError(t, err)
+	}
+
+	selected := pool.selectFor(1, 300, baseFee)
+	require.Len(t, selected, 2)
+	require.Equal(t, bundles[1].Hash(), selected[0].hash)
+	require.Equal(t, uint64(3_000_000_000), selected[0].tip.Uint64())
+	require.Equal(t, bundles[0].Hash(), selected[1].hash)
+	require.Len(t, selected[0].txs, 2)
+
+	selected = pool.selectFor(1, 150, baseFee)
+	require.Len(t, selected, 3)
+	require.Equal(t, bundles[3].Hash(), selected[0].hash)
+
+	// each bundle is executed in the block being built, on top of the
+	// bundles selected before it
+	executed := keeper.blocks[len(keeper.blocks)-3:]
+	require.Len(t, executed[2].Calls, 4)
+	require.Equal(t, big.NewInt(1), executed[2].BlockOverrides.Number.ToInt())
+	require.Equal(t, hexutil.Uint64(150), *executed[2].BlockOverrides.Time)
+
+	// a bundle which only fails on top of a higher tip bundle is not
+	// selected, but stays in the pool
+	keeper.conflicts[simulatedCallOf(t, bundles[0].Txs[0])] = simulatedCallOf(t, bundles[1].Txs[0])
+	selected = pool.selectFor(1, 300, baseFee)
+	require.Len(t, selected, 1)
+	require.Equal(t, bundles[1].Hash(), selected[0].hash)
+	require.Contains(t, pool.bundles, bundles[0].Hash())
+	clear(keeper.conflicts)
+
+	// a bundle which fails the simulation on top of the latest state is
+	// dropped, whether in the ante handler or on execution
+	rechecker.failing[bundles[1].Txs[1].Hash()] = true
+	keeper.reverting[simulatedCallOf(t, bundles[3].Txs[0])] = true
+	selected = pool.selectFor(1, 150, baseFee)
+	require.Len(t, selected, 1)
+	require.Equal(t, bundles[0].Hash(), selected[0].hash)
+	require.NotContains(t, pool.bundles, bundles[1].Hash())
+	require.NotContains(t, pool.bundles, bundles[3].Hash())
+
+	// the bundles targeting a committed block are pruned
+	pool.prune(&ethtypes.Header{Number: big.NewInt(1)})
+	require.Len(t, pool.bundles, 1)
+	require.Contains(t, pool.bundles, bundles[2].Hash())
+	require.Len(t, pool.members, 1)
+}
+
+func TestBundlePool_SelectForSimulations(t *testing.T) {
+	pool, _, _ := setupBundlePool(t)
+
+	bundles := make([]*Bundle, maxBundleSimulations+1)
+	for i := range bundles {
+		_, key := newAddrKey(t)
+		bundles[i] = &Bundle{Txs: []*ethtypes.Transaction{bundleTx(t, key, 0, int64(i+1)*1_000_000_000)}, BlockNumber: 2}
+		_, err := pool.add(bundles[i])
+		require.NoError(t, err)
+	}
+
+	// the lowest tip bundle is left out of the block once the simulations
+	// are exhausted, but it stays in the pool
+	selected := pool.selectFor(2, 0, nil)
+	require.Len(t, selected, maxBundleSimulations)
+	for _, bundle := range selected {
+		require.NotEqual(t, bundles[0].Hash(), bundle.hash)
+	}
+	require.Contains(t, pool.bundles, bundles[0].Hash())
+}
+
+func TestWithoutBundleTxs(t *testing.T) {
+	_, b := setupIteratorTest(t)
+	addr, key := newAddrKey(t)
+	otherAddr, otherKey := newAddrKey(t)
+
+	txs := make([]*txpool.LazyTransaction, 3)
+	for i := range txs {
+		txs[i] = buildEVMTx(t, key, uint64(i), big.NewInt(1_000_000_000), big.NewInt(1_000_000_000), b.Config().ChainID)
+	}
+	other := buildEVMTx(t, otherKey, 0, big.NewInt(1_000_000_000), big.NewInt(1_000_000_000), b.Config().ChainID)
+	pending := map[common.Address][]*txpool.LazyTransaction{addr: txs, otherAddr: {other}}
+
+	require.Equal(t, pending, withoutBundleTxs(pending, nil))
+
+	// the pooled tx with the nonce of a member is dropped along with the
+	// later txs of its sender
+	bundles := []selectedBundle{{ethTxs: []*ethtypes.Transaction{bundleTx(t, key, 1, 2_000_000_000)}}}
+	require.Equal(t, map[common.Address][]*txpool.LazyTransaction{
+		addr:      txs[:1],
+		otherAddr: {other},
+	}, withoutBundleTxs(pending, bundles))
+
+	bundles = []selectedBundle{{ethTxs: []*ethtypes.Transaction{other.Tx}}}
+	require.Equal(t, map[common.Address][]*txpool.LazyTransaction{addr: txs}, withoutBundleTxs(pending, bundles))
+}
+
+func TestIterator_Bundle(t *testing.T) {
+	pool, _, _ := setupBundlePool(t)
+	txConfig, b := setupIteratorTest(t)
+
+	highAddr, highKey := newAddrKey(t)
+	lowAddr, lowKey := newAddrKey(t)
+	_, bundleKey := newAddrKey(t)
+
+	high := buildEVMTx(t, highKey, 0, big.NewInt(10_000_000_000), big.NewInt(10_000_000_000), b.Config().ChainID)
+	low := buildEVMTx(t, lowKey, 0, big.NewInt(1_000_000_000), big.NewInt(1_000_000_000), b.Config().ChainID)
+	evmIter := makeEVMIterator(map[common.Address][]*txpool.LazyTransaction{
+		highAddr: {high},
+		lowAddr:  {low},
+	}, nil)
+
+	bundle := &Bundle{
+		Txs:         []*ethtypes.Transaction{bundleTx(t, bundleKey, 0, 5_000_000_000), bundleTx(t, bundleKey, 1, 5_000_000_000)},
+		BlockNumber: 1,
+	}
+	_, err := pool.add(bundle)
+	require.NoError(t, err)
+	bundles := pool.selectFor(1, 0, nil)
+	require.Len(t, bundles, 1)
+
+	iter := newEVMMempoolIterator(evmIter, nil, bundles, log.NewNopLogger(), txConfig, b)
+	require.NotNil(t, iter)
+
+	// the bundle members are returned contiguously at the tip of the bundle
+	result := collectAll(t, iter)
+	require.Len(t, result, 4)
+	hashes := make([]common.Hash, len(result))
+	for i, tx := range result {
+		hashes[i] = tx.GetMsgs()[0].(*vmtypes.MsgEthereumTx).Hash()
+	}
+	require.Equal(t, []common.Hash{high.Hash, bundle.Txs[0].Hash(), bundle.Txs[1].Hash(), low.Hash}, hashes)
+
+	// the bundles alone make a non empty iterator
+	require.NotNil(t, newEVMMempoolIterator(nil, nil, bundles, log.NewNopLogger(), txConfig, b))
+}
+
+func TestBundleTxSelector(t *testing.T) {
+	pool, _, _ := setupBundlePool(t)
+	_, bundleKey := newAddrKey(t)
+	_, otherKey := newAddrKey(t)
+
+	bundle := &Bundle{
+		Txs:         []*ethtypes.Transaction{bundleTx(t, bundleKey, 0, 2_000_000_000), bundleTx(t, bundleKey, 1, 2_000_000_000)},
+		BlockNumber: 1,
+	}
+	_, err := pool.add(bundle)
+	require.NoError(t, err)
+	require.Len(t, pool.selectFor(1, 0, nil), 1)
+	members := pool.bundles[bundle.Hash()]
+
+	encode := func(tx *ethtypes.Transaction) (sdk.Tx, []byte) {
+		cosmosTx, err := pool.encoder.EVMTxToCosmosTx(tx)
+		require.NoError(t, err)
+		bz, err := pool.encoder.CosmosTx(cosmosTx)
+		require.NoError(t, err)
+		return cosmosTx, bz
+	}
+	other, otherBz := encode(bundleTx(t, otherKey, 0, 2_000_000_000))
+	later, laterBz := encode(bundleTx(t, bundleKey, 2, 2_000_000_000))
+
+	mp := &Mempool{
+		bundles:         pool,
+		signerExtractor: NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+	}
+	selector := mp.NewTxSelector()
+	ctx := context.Background()
+	selectTx := func(tx sdk.Tx, bz []byte, maxTxBytes uint64) bool {
+		stop := selector.SelectTxForProposal(ctx, maxTxBytes, 0, tx, bz)
+		// the proposal handler reads the selection after each selected tx
+		selector.SelectedTxs(ctx)
+		return stop
+	}
+
+	t.Run("complete bundle", func(t *testing.T) {
+		defer selector.Clear()
+		selectTx(members.txs[0], members.txBytes[0], 1<<20)
+		selectTx(members.txs[1], members.txBytes[1], 1<<20)
+		selectTx(other, otherBz, 1<<20)
+		require.Equal(t, [][]byte{members.txBytes[0], members.txBytes[1], otherBz}, selector.SelectedTxs(ctx))
+	})
+
+	t.Run("skipped member", func(t *testing.T) {
+		defer selector.Clear()
+		selectTx(members.txs[0], members.txBytes[0], 1<<20)
+		selectTx(other, otherBz, 1<<20)
+		// the later txs of the bundle senders follow the rolled back members
+		selectTx(later, laterBz, 1<<20)
+		require.Equal(t, [][]byte{otherBz}, selector.SelectedTxs(ctx))
+	})
+
+	t.Run("member out of order", func(t *testing.T) {
+		defer selector.Clear()
+		selectTx(members.txs[1], members.txBytes[1], 1<<20)
+		selectTx(other, otherBz, 1<<20)
+		require.Equal(t, [][]byte{otherBz}, selector.SelectedTxs(ctx))
+	})
+
+	t.Run("incomplete bundle", func(t *testing.T) {
+		defer selector.Clear()
+		selectTx(other, otherBz, 1<<20)
+		require.False(t, selectTx(members.txs[0], members.txBytes[0], 1<<20))
+		require.Equal(t, [][]byte{otherBz}, selector.SelectedTxs(ctx))
+	})
+
+	t.Run("bundle doesn't fit", func(t *testing.T) {
+		defer selector.Clear()
+		maxTxBytes := uint64(len(members.txBytes[0])+len(members.txBytes[1])) + 2
+		// the selection never stops within a bundle
+		require.False(t, selectTx(members.txs[0], members.txBytes[0], maxTxBytes))
+		selectTx(members.txs[1], members.txBytes[1], maxTxBytes)
+		require.Empty(t, selector.SelectedTxs(ctx))
+	})
+}
+
+// proposalMempool returns its txs in order to the proposal handler.
+type proposalMempool struct {
+	txs []sdk.Tx
+}
+
+func (m *proposalMempool) Insert(context.Context, sdk.Tx) error { return nil }
+
+func (m *proposalMempool) Select(context.Context, [][]byte) sdkmempool.Iterator {
+	if len(m.txs) == 0 {
+		return nil
+	}
+	return &proposalIterator{txs: m.txs}
+}
+
+func (m *proposalMempool) CountTx() int { return len(m.txs) }
+
+func (m *proposalMempool) Remove(sdk.Tx) error { return nil }
+
+type proposalIterator struct {
+	txs []sdk.Tx
+}
+
+func (i *proposalIterator) Next() sdkmempool.Iterator {
+	if len(i.txs) <= 1 {
+		return nil
+	}
+	return &proposalIterator{txs: i.txs[1:]}
+}
+
+func (i *proposalIterator) Tx() sdk.Tx { return i.txs[0] }
+
+// proposalTxVerifier encodes the txs of the proposal, and fails the ones marked
+// as invalid.
+type proposalTxVerifier struct {
+	encoder *TxEncoder
+	invalid map[sdk.Tx]bool
+}
+
+func (v *proposalTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	if v.invalid[tx] {
+		return nil, errors.New("invalid tx")
+	}
+	return v.encoder.CosmosTx(tx)
+}
+
+func (v *proposalTxVerifier) ProcessProposalVerifyTx([]byte) (sdk.Tx, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (v *proposalTxVerifier) TxDecode([]byte) (sdk.Tx, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (v *proposalTxVerifier) TxEncode(tx sdk.Tx) ([]byte, error) {
+	return v.encoder.CosmosTx(tx)
+}
+
+func TestBundleTxSelector_ProposalHandler(t *testing.T) {
+	pool, _, _ := setupBundlePool(t)
+	_, bundleKey := newAddrKey(t)
+	_, otherKey := newAddrKey(t)
+
+	bundle := &Bundle{
+		Txs:         []*ethtypes.Transaction{bundleTx(t, bundleKey, 0, 2_000_000_000), bundleTx(t, bundleKey, 1, 2_000_000_000)},
+		BlockNumber: 1,
+	}
+	_, err := pool.add(bundle)
+	require.NoError(t, err)
+	require.Len(t, pool.selectFor(1, 0, nil), 1)
+	members := pool.bundles[bundle.Hash()]
+
+	encode := func(tx *ethtypes.Transaction) (sdk.Tx, []byte) {
+		cosmosTx, err := pool.encoder.EVMTxToCosmosTx(tx)
+		require.NoError(t, err)
+		bz, err := pool.encoder.CosmosTx(cosmosTx)
+		require.NoError(t, err)
+		return cosmosTx, bz
+	}
+	other, otherBz := encode(bundleTx(t, otherKey, 0, 2_000_000_000))
+	later, _ := encode(bundleTx(t, bundleKey, 2, 2_000_000_000))
+
+	mp := &Mempool{
+		bundles:         pool,
+		signerExtractor: NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+	}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	prepare := func(txs []sdk.Tx, invalid map[sdk.Tx]bool, maxTxBytes int64) [][]byte {
+		// the selector is driven by the proposal handler of the sdk, as set up
+		// by the apps consuming the mempool
+		handler := baseapp.NewDefaultProposalHandler(
+			&proposalMempool{txs: txs},
+			&proposalTxVerifier{encoder: pool.encoder, invalid: invalid},
+		)
+		handler.SetTxSelector(mp.NewTxSelector())
+		res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+		require.NoError(t, err)
+		return res.Txs
+	}
+
+	t.Run("complete bundle", func(t *testing.T) {
+		txs := prepare([]sdk.Tx{members.txs[0], members.txs[1], other}, nil, 1<<20)
+		require.Equal(t, [][]byte{members.txBytes[0], members.txBytes[1], otherBz}, txs)
+	})
+
+	t.Run("invalid member", func(t *testing.T) {
+		// the handler skips the invalid member without calling the selector
+		txs := prepare([]sdk.Tx{members.txs[0], members.txs[1], other, later}, map[sdk.Tx]bool{members.txs[1]: true}, 1<<20)
+		require.Equal(t, [][]byte{otherBz}, txs)
+	})
+
+	t.Run("incomplete bundle", func(t *testing.T) {
+		txs := prepare([]sdk.Tx{other, members.txs[0]}, nil, 1<<20)
+		require.Equal(t, [][]byte{otherBz}, txs)
+	})
+
+	t.Run("bundle doesn't fit", func(t *testing.T) {
+		size := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{members.txBytes[0], members.txBytes[1]})
+		txs := prepare([]sdk.Tx{members.txs[0], members.txs[1], other}, nil, size-1)
+		require.Equal(t, [][]byte{otherBz}, txs)
+	})
+}
@@ -14,6 +14,22 @@ var (
 	ErrMultiMsgEthereumTransaction = errors.New("transaction contains multiple messages with an EVM msg")
 	ErrNonceGap                    = errors.New("tx nonce is higher than account nonce")
 	ErrNonceLow                    = errors.New("tx nonce is lower than account nonce")
+	ErrBundlesDisabled             = errors.New("bundles are not supported by the mempool")
+	ErrBundleEmpty                 = errors.New("bundle has no transactions")
+	ErrBundleTooLarge              = errors.New("bundle has too many transactions")
+	ErrBundlePoolFull              = errors.New("bundle pool is full")
+	ErrBundleKnown                 = errors.New("bundle already known")
+	ErrBundleTxConflict            = errors.New("bundle transaction is already part of another bundle")
+	ErrBundleTxPooled              = errors.New("bundle transaction conflicts with a transaction of the mempool")
+	ErrBundleBlockPassed           = errors.New("bundle target block has already been committed")
+	ErrBundleTimestamps            = errors.New("bundle min timestamp is greater than its max timestamp")
+	ErrPrivateTxsDisabled          = errors.New("private transactions are not supported by the mempool")
+	ErrPrivateTxKnown              = errors.New("private transaction already known")
+	ErrPrivateTxPoolFull           = errors.New("private transaction pool is full")
+	ErrPrivateTxExpired            = errors.New("private transaction max block has already been committed")
+	ErrPrivateTxNonceConflict      = errors.New("private transaction nonce is already used by another private transaction")
+	ErrCosmosReplaceUnderpriced    = errors.New("replacement cosmos transaction underpriced")
+	ErrCosmosAccountSlotsFull      = errors.New("account has no cosmos transaction slots left")
 	// ErrQueueFull is aliased from the internal queue package so that external
 	// packages (e.g. evmd) can check for this error without importing internal/.
 	ErrQueueFull = queue.ErrQueueFull
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -28,6 +29,7 @@ type VMKeeperI interface {
 	SetCode(ctx sdk.Context, codeHash []byte, code []byte)
 	DeleteAccount(ctx sdk.Context, addr common.Address) error
 	KVStoreKeys() map[string]storetypes.StoreKey
+	SimulateV1(ctx context.Context, req *vmtypes.SimulateV1Request) (*vmtypes.SimulateV1Response, error)
 }
 
 type FeeMarketKeeperI interface {
@@ -59,6 +59,13 @@ type EVMMempoolIterator struct {
 	// call to Next()
 	nextCosmosAction nextAction
 
+	// bundles are the bundles selected for the block, sorted by tip. The
+	// members of a bundle are returned contiguously, bundleTx is the index of
+	// the current member of the first bundle, or -1 if the current tx is not
+	// a bundle member.
+	bundles  []selectedBundle
+	bundleTx int
+
 	/** Utils **/
 	logger   log.Logger
 	txConfig client.TxConfig
@@ -80,11 +87,25 @@ func NewEVMMempoolIterator(
 	logger log.Logger,
 	txConfig client.TxConfig,
 	blockchain *Blockchain,
+) mempool.Iterator {
+	return newEVMMempoolIterator(evmIterator, cosmosIterator, nil, logger, txConfig, blockchain)
+}
+
+// newEVMMempoolIterator creates a new unified iterator over the EVM and Cosmos
+// transactions and the bundles selected for the block. A bundle is returned
+// as a unit, before the transactions with a lower fee than its tip.
+func newEVMMempoolIterator(
+	evmIterator *miner.TransactionsByPriceAndNonce,
+	cosmosIterator mempool.Iterator,
+	bundles []selectedBundle,
+	logger log.Logger,
+	txConfig client.TxConfig,
+	blockchain *Blockchain,
 ) mempool.Iterator {
 	hasEVM := evmIterator != nil && !evmIterator.Empty()
 	hasCosmos := cosmosIterator != nil && cosmosIterator.Tx() != nil
 
-	if !hasEVM && !hasCosmos {
+	if !hasEVM && !hasCosmos && len(bundles) == 0 {
 		return nil
 	}
 
@@ -93,6 +114,8 @@ func NewEVMMempoolIterator(
 		cosmosIterator:   cosmosIterator,
 		nextEVMAction:    none,
 		nextCosmosAction: none,
+		bundles:          bundles,
+		bundleTx:         -1,
 		logger:           logger,
 		txConfig:         txConfig,
 		bondDenom:        blockchain.GetCoinDenom(),
@@ -173,9 +196,21 @@ func (i *EVMMempoolIterator) Tx() sdk.Tx {
 // iterators and caches it. This is called once at construction and once after each
 // advance, eliminating all redundant fee calculations and iterator peeks.
 func (i *EVMMempoolIterator) resolveCurrentTx() {
+	// return the remaining members of the current bundle first
+	if i.nextBundleTx() {
+		return
+	}
+
 	evmTx, evmFee := i.peekEVM()
 	cosmosTx, cosmosFee := i.peekCosmos()
 
+	if i.shouldSelectBundle(evmTx, evmFee, cosmosTx, cosmosFee) {
+		i.nextEVMAction, i.nextCosmosAction = none, none
+		i.bundleTx = 0
+		i.currentTx = i.bundles[0].txs[0]
+		return
+	}
+
 	if evmTx == nil && cosmosTx == nil {
 		i.nextEVMAction, i.nextCosmosAction = none, none
 		i.currentTx = nil
@@ -206,6 +241,45 @@ func (i *EVMMempoolIterator) resolveCurrentTx() {
 	i.currentTx = cosmosTx
 }
 
+// nextBundleTx moves to the next member of the current bundle, dropping the
+// bundle once all its members were returned. It returns false if the current
+// tx was not a bundle member or was the last one.
+func (i *EVMMempoolIterator) nextBundleTx() bool {
+	if i.bundleTx < 0 {
+		return false
+	}
+
+	i.bundleTx++
+	if i.bundleTx < len(i.bundles[0].txs) {
+		i.currentTx = i.bundles[0].txs[i.bundleTx]
+		return true
+	}
+
+	i.bundles = i.bundles[1:]
+	i.bundleTx = -1
+	return false
+}
+
+// shouldSelectBundle determines if the next bundle should be used based on a
+// comparison of its tip with the fees of the EVM and Cosmos txs. Bundles are
+// preferred unless their tip is strictly lower.
+func (i *EVMMempoolIterator) shouldSelectBundle(
+	evmTx *txpool.LazyTransaction,
+	evmFee *uint256.Int,
+	cosmosTx sdk.Tx,
+	cosmosFee *uint256.Int,
+) bool {
+	if len(i.bundles) == 0 {
+		return false
+	}
+
+	tip := i.bundles[0].tip
+	if evmTx != nil && tip.Lt(evmFee) {
+		return false
+	}
+	return cosmosTx == nil || !tip.Lt(cosmosFee)
+}
+
 // shouldSelectEVMTx determines if the EVM tx should be used based on a fee
 // comparison. Returns true if the evmTx should be selected, false if the
 // cosmosTx.
@@ -282,6 +282,8 @@ func setupBenchMempool(b *testing.B, evmAccounts, cosmosAccounts []benchAccount)
 		txConfig,
 		evmRechecker,
 		cosmosRechecker,
+		nil,
+		nil,
 		config,
 		0,
 	)
@@ -0,0 +1,325 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// JournalTxsAll journals both the EVM and the Cosmos txs.
+	JournalTxsAll = "all"
+	// JournalTxsEVM journals the EVM txs only.
+	JournalTxsEVM = "evm"
+	// JournalTxsCosmos journals the Cosmos txs only.
+	JournalTxsCosmos = "cosmos"
+
+	// defaultJournalInterval is the journal rotation interval used if none is
+	// configured.
+	defaultJournalInterval = time.Hour
+)
+
+// errNoActiveJournal is returned if a transaction is attempted to be inserted
+// into the journal, but no such file is currently open.
+var errNoActiveJournal = errors.New("no active journal")
+
+// journalEntry is a tx stored in the journal. EVM txs are stored in their
+// binary encoding and Cosmos txs in their sdk encoding.
+type journalEntry struct {
+	Cosmos bool
+	Tx     []byte
+}
+
+// txJournal is a rotating log of the pooled txs, with the aim of storing them
+// to disk so that they survive node restarts. It mirrors the geth journal of
+// local transactions, but holds the EVM and Cosmos txs of the mempool.
+type txJournal struct {
+	mu     sync.Mutex
+	path   string         // Filesystem path to store the transactions at
+	writer io.WriteCloser // Output stream to write new transactions into
+}
+
+// newTxJournal creates a new transaction journal at the given path.
+func newTxJournal(path string) *txJournal {
+	return &txJournal{path: path}
+}
+
+// load parses a transaction journal dump from disk. A journal truncated by a
+// crash while writing returns the entries read up to the truncation.
+func (j *txJournal) load() ([]journalEntry, error) {
+	input, err := os.Open(j.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		// Skip the parsing if the journal file doesn't exist at all
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	var (
+		stream  = rlp.NewStream(input, 0)
+		entries []journalEntry
+	)
+	for {
+		var entry journalEntry
+		if err := stream.Decode(&entry); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return entries, nil
+			}
+			return entries, err
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// insert adds the specified entries to the local disk journal.
+func (j *txJournal) insert(entries ...journalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+	for _, entry := range entries {
+		if err := rlp.Encode(j.writer, &entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rotate regenerates the transaction journal based on the current contents of
+// the mempool and opens it for appending the new transactions. The contents
+// are collected while holding the journal, so that no tx inserted meanwhile is
+// lost.
+func (j *txJournal) rotate(contents func() []journalEntry) (int, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	// Close the current journal (if any is open)
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return 0, err
+		}
+		j.writer = nil
+	}
+	// Generate a new journal with the contents of the current pool
+	replacement, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	entries := contents()
+	for _, entry := range entries {
+		if err = rlp.Encode(replacement, &entry); err != nil {
+			replacement.Close()
+			return 0, err
+		}
+	}
+	replacement.Close()
+
+	// Replace the live journal with the newly generated one
+	if err = os.Rename(j.path+".new", j.path); err != nil {
+		return 0, err
+	}
+	sink, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	j.writer = sink
+	return len(entries), nil
+}
+
+// close flushes the transaction journal contents to disk and closes the file.
+func (j *txJournal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var err error
+	if j.writer != nil {
+		err = j.writer.Close()
+		j.writer = nil
+	}
+	return err
+}
+
+// LoadJournal replays the txs of the journal into the mempool and starts
+// rotating the journal. The EVM txs are added to the txpool and the Cosmos txs
+// are rechecked on insertion, the entries which have become invalid since
+// they were journaled are dropped. It is a no-op if the journal is disabled,
+// and must be called once the chain state is loaded.
+func (m *Mempool) LoadJournal() error {
+	if m.journal == nil {
+		return nil
+	}
+
+	entries, err := m.journal.load()
+	if err != nil {
+		// keep the entries read before the corruption, the journal is
+		// rewritten below
+		m.logger.Warn("failed to load the full mempool journal", "path", m.journal.path, "err", err)
+	}
+
+	var (
+		evmTxs  []*ethtypes.Transaction
+		dropped int
+	)
+	for _, entry := range entries {
+		if !entry.Cosmos {
+			tx := new(ethtypes.Transaction)
+			if err := tx.UnmarshalBinary(entry.Tx); err != nil {
+				dropped++
+				continue
+			}
+			evmTxs = append(evmTxs, tx)
+			continue
+		}
+		tx, err := m.txConfig.TxDecoder()(entry.Tx)
+		if err != nil {
+			dropped++
+			continue
+		}
+		if err := m.recheckCosmosPool.Insert(context.Background(), tx); err != nil {
+			m.logger.Debug("dropping journaled cosmos tx", "err", err)
+			dropped++
+		}
+	}
+	for i, err := range m.txPool.Add(evmTxs, AllowUnsafeSyncInsert) {
+		if err != nil {
+			m.logger.Debug("dropping journaled evm tx", "tx_hash", evmTxs[i].Hash(), "err", err)
+			dropped++
+		}
+	}
+	m.logger.Info("loaded mempool journal", "path", m.journal.path, "transactions", len(entries), "dropped", dropped)
+
+	if err := m.rotateJournal(); err != nil {
+		return fmt.Errorf("rotating mempool journal: %w", err)
+	}
+
+	m.journalWG.Go(m.journalLoop)
+	return nil
+}
+
+// journalLoop rotates the journal every journal interval until the mempool is
+// closed.
+func (m *Mempool) journalLoop() {
+	ticker := time.NewTicker(m.journalInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.rotateJournal(); err != nil {
+				m.logger.Warn("failed to rotate mempool journal", "err", err)
+			}
+		case <-m.journalShutdownCh:
+			return
+		}
+	}
+}
+
+// rotateJournal regenerates the journal from the txs currently held by the
+// mempool.
+func (m *Mempool) rotateJournal() error {
+	count, err := m.journal.rotate(m.journalContents)
+	if err != nil {
+		return err
+	}
+	m.logger.Debug("regenerated mempool journal", "transactions", count)
+	return nil
+}
+
+// journalContents returns the journal entries of the txs currently held by the
+// mempool. The txs of each sender are journaled in nonce order, so that they
+// can be replayed in order.
+func (m *Mempool) journalContents() []journalEntry {
+	var entries []journalEntry
+	if m.journalEVM {
+		pending, queued := m.legacyTxPool.Content()
+		for _, content := range []map[common.Address][]*ethtypes.Transaction{pending, queued} {
+			for _, txs := range content {
+				for _, tx := range txs {
+					if entry, ok := m.evmJournalEntry(tx); ok {
+						entries = append(entries, entry)
+					}
+				}
+			}
+		}
+	}
+	if m.journalCosmos {
+		for it := m.recheckCosmosPool.Select(context.Background(), nil); it != nil; it = it.Next() {
+			if entry, ok := m.cosmosJournalEntry(it.Tx()); ok {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries
+}
+
+// journalEVMTxs appends the EVM txs added to the txpool to the journal.
+func (m *Mempool) journalEVMTxs(txs []*ethtypes.Transaction, errs []error) {
+	if m.journal == nil || !m.journalEVM {
+		return
+	}
+	entries := make([]journalEntry, 0, len(txs))
+	for i, tx := range txs {
+		if errs[i] != nil {
+			continue
+		}
+		if entry, ok := m.evmJournalEntry(tx); ok {
+			entries = append(entries, entry)
+		}
+	}
+	m.insertJournal(entries)
+}
+
+// journalCosmosTx appends a Cosmos tx inserted into the cosmos pool to the
+// journal.
+func (m *Mempool) journalCosmosTx(tx sdk.Tx) {
+	if m.journal == nil || !m.journalCosmos {
+		return
+	}
+	if entry, ok := m.cosmosJournalEntry(tx); ok {
+		m.insertJournal([]journalEntry{entry})
+	}
+}
+
+// insertJournal appends the entries to the journal. The entries are skipped
+// until the journal is loaded.
+func (m *Mempool) insertJournal(entries []journalEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	if err := m.journal.insert(entries...); err != nil && !errors.Is(err, errNoActiveJournal) {
+		m.logger.Warn("failed to journal txs", "err", err)
+	}
+}
+
+func (m *Mempool) evmJournalEntry(tx *ethtypes.Transaction) (journalEntry, bool) {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		m.logger.Warn("failed to encode evm tx for the journal", "tx_hash", tx.Hash(), "err", err)
+		return journalEntry{}, false
+	}
+	return journalEntry{Tx: bz}, true
+}
+
+func (m *Mempool) cosmosJournalEntry(tx sdk.Tx) (journalEntry, bool) {
+	bz, err := m.txConfig.TxEncoder()(tx)
+	if err != nil {
+		m.logger.Warn("failed to encode cosmos tx for the journal", "err", err)
+		return journalEntry{}, false
+	}
+	return journalEntry{Cosmos: true, Tx: bz}, true
+}
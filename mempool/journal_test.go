@@ -0,0 +1,55 @@
+package mempool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool_journal.rlp")
+	journal := newTxJournal(path)
+
+	// a missing journal loads no entries
+	entries, err := journal.load()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// the entries are not journaled until the journal is rotated
+	require.ErrorIs(t, journal.insert(journalEntry{Tx: []byte{0x01}}), errNoActiveJournal)
+
+	pooled := []journalEntry{{Tx: []byte{0x02}}, {Cosmos: true, Tx: []byte{0x03}}}
+	count, err := journal.rotate(func() []journalEntry { return pooled })
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.NoError(t, journal.insert(journalEntry{Cosmos: true, Tx: []byte{0x04}}))
+	require.NoError(t, journal.close())
+
+	entries, err = journal.load()
+	require.NoError(t, err)
+	require.Equal(t, append(pooled, journalEntry{Cosmos: true, Tx: []byte{0x04}}), entries)
+
+	// the rotation replaces the journaled entries with the pooled ones
+	count, err = journal.rotate(func() []journalEntry { return pooled[:1] })
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.NoError(t, journal.close())
+
+	entries, err = journal.load()
+	require.NoError(t, err)
+	require.Equal(t, pooled[:1], entries)
+
+	// a journal truncated while writing loads the entries before the truncation
+	_, err = journal.rotate(func() []journalEntry { return pooled })
+	require.NoError(t, err)
+	require.NoError(t, journal.close())
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	entries, err = journal.load()
+	require.NoError(t, err)
+	require.Equal(t, pooled[:1], entries)
+}
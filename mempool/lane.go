@@ -0,0 +1,454 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/mempool/miner"
+	"github.com/cosmos/evm/mempool/txpool"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Ordering is the policy ordering the txs of a lane.
+type Ordering int
+
+const (
+	// OrderByFee orders the txs by their effective tip, then by the time they
+	// were first seen. It is the ordering of the mempool without lanes.
+	OrderByFee Ordering = iota
+	// OrderByArrival orders the txs by the time they were first seen,
+	// regardless of their tip (first come, first served).
+	OrderByArrival
+)
+
+// defaultLaneName is the name of the lane of the txs which match no lane.
+const defaultLaneName = "default"
+
+var evmMsgTypeURL = sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+// TxMatcher matches the txs of a lane.
+type TxMatcher interface {
+	// MatchEVMTx reports whether the EVM tx sent by from belongs to the lane.
+	MatchEVMTx(tx *ethtypes.Transaction, from common.Address) bool
+	// MatchCosmosTx reports whether the Cosmos tx belongs to the lane.
+	MatchCosmosTx(tx sdk.Tx) bool
+}
+
+// Lane is a part of the blocks built from the mempool, reserved for the txs
+// matched by its matcher. The lanes of the mempool are included in the blocks
+// in the order they are configured, each up to its share of the block gas
+// limit, followed by the txs which match no lane, ordered by fee. A tx belongs
+// to the first lane matching it, unless an earlier tx of its sender belongs to
+// a later lane, so that the txs of a sender are included in nonce order.
+//
+// The bundles and the private txs are included with the txs which match no
+// lane. The txs are split into the lanes after the base fee and min tip filter
+// of the pending txs, and go through the fee checks of the ante handler, so a
+// lane doesn't exempt its txs from the fees: the lanes are configured by each
+// node, while a fee exemption must be enforced by all the validators.
+type Lane struct {
+	// Name identifies the lane in the logs.
+	Name string
+	// Matcher matches the txs of the lane.
+	Matcher TxMatcher
+	// Ordering orders the EVM txs of the lane. The Cosmos txs carry no arrival
+	// time, they are ordered by fee in all lanes and interleaved with the EVM
+	// txs by fee.
+	Ordering Ordering
+	// MaxBlockGasShare is the max share of the block gas limit used by the txs
+	// of the lane, between 0 and 1. A nil or zero share doesn't limit the lane.
+	MaxBlockGasShare math.LegacyDec
+}
+
+// Validate returns an error if the lane is invalid.
+func (l Lane) Validate() error {
+	if l.Name == "" {
+		return errors.New("lane name cannot be empty")
+	}
+	if l.Name == defaultLaneName {
+		return fmt.Errorf("lane name '%s' is reserved", defaultLaneName)
+	}
+	if l.Matcher == nil {
+		return fmt.Errorf("lane %s: matcher cannot be nil", l.Name)
+	}
+	if l.Ordering != OrderByFee && l.Ordering != OrderByArrival {
+		return fmt.Errorf("lane %s: unknown ordering %d", l.Name, l.Ordering)
+	}
+	if !l.MaxBlockGasShare.IsNil() && (l.MaxBlockGasShare.IsNegative() || l.MaxBlockGasShare.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("lane %s: max block gas share must be between 0 and 1, got %s", l.Name, l.MaxBlockGasShare)
+	}
+	return nil
+}
+
+// maxGas returns the max gas used by the txs of the lane in a block with the
+// gas limit, or 0 if the lane is not limited.
+func (l Lane) maxGas(blockGasLimit uint64) uint64 {
+	if l.MaxBlockGasShare.IsNil() || l.MaxBlockGasShare.IsZero() {
+		return 0
+	}
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(blockGasLimit)).Mul(l.MaxBlockGasShare).TruncateInt().Uint64()
+}
+
+func validateLanes(lanes []Lane) error {
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if err := lane.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[lane.Name]; ok {
+			return fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+	}
+	return nil
+}
+
+type msgTypeMatcher map[string]struct{}
+
+// MatchMsgTypes matches the txs whose msgs all have one of the type URLs, e.g.
+// the oracle price updates. The EVM txs are matched if the type URL of
+// MsgEthereumTx is one of them.
+func MatchMsgTypes(typeURLs ...string) TxMatcher {
+	m := make(msgTypeMatcher, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		m[typeURL] = struct{}{}
+	}
+	return m
+}
+
+func (m msgTypeMatcher) MatchEVMTx(*ethtypes.Transaction, common.Address) bool {
+	_, ok := m[evmMsgTypeURL]
+	return ok
+}
+
+func (m msgTypeMatcher) MatchCosmosTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if _, ok := m[sdk.MsgTypeURL(msg)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type contractMatcher map[common.Address]struct{}
+
+// MatchContracts matches the EVM txs calling one of the contracts, e.g. the
+// whitelisted system contracts. It matches no Cosmos tx.
+func MatchContracts(contracts ...common.Address) TxMatcher {
+	m := make(contractMatcher, len(contracts))
+	for _, contract := range contracts {
+		m[contract] = struct{}{}
+	}
+	return m
+}
+
+func (m contractMatcher) MatchEVMTx(tx *ethtypes.Transaction, _ common.Address) bool {
+	if tx.To() == nil {
+		return false
+	}
+	_, ok := m[*tx.To()]
+	return ok
+}
+
+func (m contractMatcher) MatchCosmosTx(sdk.Tx) bool {
+	return false
+}
+
+type senderMatcher struct {
+	senders         map[common.Address]struct{}
+	signerExtractor sdkmempool.SignerExtractionAdapter
+}
+
+// MatchSenders matches the EVM txs sent by one of the senders, and the Cosmos
+// txs all signed by them.
+func MatchSenders(senders ...common.Address) TxMatcher {
+	m := &senderMatcher{
+		senders:         make(map[common.Address]struct{}, len(senders)),
+		signerExtractor: sdkmempool.NewDefaultSignerExtractionAdapter(),
+	}
+	for _, sender := range senders {
+		m.senders[sender] = struct{}{}
+	}
+	return m
+}
+
+func (m *senderMatcher) MatchEVMTx(_ *ethtypes.Transaction, from common.Address) bool {
+	_, ok := m.senders[from]
+	return ok
+}
+
+func (m *senderMatcher) MatchCosmosTx(tx sdk.Tx) bool {
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil || len(signers) == 0 {
+		return false
+	}
+	for _, signer := range signers {
+		if _, ok := m.senders[common.BytesToAddress(signer.Signer)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type anyMatcher []TxMatcher
+
+// MatchAny matches the txs matched by one of the matchers.
+func MatchAny(matchers ...TxMatcher) TxMatcher {
+	return anyMatcher(matchers)
+}
+
+func (m anyMatcher) MatchEVMTx(tx *ethtypes.Transaction, from common.Address) bool {
+	for _, matcher := range m {
+		if matcher.MatchEVMTx(tx, from) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m anyMatcher) MatchCosmosTx(tx sdk.Tx) bool {
+	for _, matcher := range m {
+		if matcher.MatchCosmosTx(tx) {
+			return true
+		}
+	}
+	return false
+}
+
+// laneIterator builds an iterator over the pending EVM and Cosmos txs split
+// into the lanes of the mempool, and the bundles selected for the block.
+func (m *Mempool) laneIterator(
+	evmPending map[common.Address][]*txpool.LazyTransaction,
+	cosmosIterator sdkmempool.Iterator,
+	baseFee *big.Int,
+	bundles []selectedBundle,
+) sdkmempool.Iterator {
+	evmTxs := splitEVMTxs(m.lanes, evmPending)
+	cosmosTxs := splitCosmosTxs(m.lanes, cosmosIterator, m.signerExtractor)
+
+	lanes := make([]*laneTxs, 0, len(m.lanes)+1)
+	for i := range len(m.lanes) + 1 {
+		name, ordering, maxGas := defaultLaneName, OrderByFee, uint64(0)
+		var laneBundles []selectedBundle
+		if i < len(m.lanes) {
+			name, ordering, maxGas = m.lanes[i].Name, m.lanes[i].Ordering, m.lanes[i].maxGas(m.blockGasLimit)
+		} else {
+			laneBundles = bundles
+		}
+
+		var evmIterator *miner.TransactionsByPriceAndNonce
+		if ordering == OrderByArrival {
+			evmIterator = miner.NewTransactionsByArrivalAndNonce(nil, evmTxs[i], baseFee)
+		} else {
+			evmIterator = miner.NewTransactionsByPriceAndNonce(nil, evmTxs[i], baseFee)
+		}
+		var laneCosmosIterator sdkmempool.Iterator
+		if len(cosmosTxs[i]) > 0 {
+			laneCosmosIterator = &cosmosTxIterator{txs: cosmosTxs[i]}
+		}
+
+		lanes = append(lanes, &laneTxs{
+			name:   name,
+			iter:   newEVMMempoolIterator(evmIterator, laneCosmosIterator, laneBundles, m.logger, m.txConfig, m.blockchain),
+			maxGas: maxGas,
+		})
+	}
+
+	iter := &laneTxIterator{
+		lanes:           lanes,
+		signerExtractor: m.signerExtractor,
+		skipped:         make(map[string]struct{}),
+		logger:          m.logger,
+	}
+	if !iter.resolve() {
+		m.laneTxs.Store(nil)
+		return nil
+	}
+	m.laneTxs.Store(iter)
+	return iter
+}
+
+// laneTxSelected accounts for the gas of the current tx of the lane iterator
+// once the tx selector of the proposal selected it.
+func (m *Mempool) laneTxSelected() {
+	if iter := m.laneTxs.Load(); iter != nil {
+		iter.selected()
+	}
+}
+
+// splitEVMTxs splits the nonce sorted txs of each sender into the lanes,
+// followed by the default lane. A tx never goes to a lane before the lane of
+// the previous tx of its sender.
+func splitEVMTxs(lanes []Lane, pending map[common.Address][]*txpool.LazyTransaction) []map[common.Address][]*txpool.LazyTransaction {
+	split := make([]map[common.Address][]*txpool.LazyTransaction, len(lanes)+1)
+	for i := range split {
+		split[i] = make(map[common.Address][]*txpool.LazyTransaction)
+	}
+
+	for from, txs := range pending {
+		lane := 0
+		for _, tx := range txs {
+			lane = max(lane, matchEVMLane(lanes, tx, from))
+			split[lane][from] = append(split[lane][from], tx)
+		}
+	}
+	return split
+}
+
+func matchEVMLane(lanes []Lane, tx *txpool.LazyTransaction, from common.Address) int {
+	if tx.Tx == nil {
+		return len(lanes)
+	}
+	for i, lane := range lanes {
+		if lane.Matcher.MatchEVMTx(tx.Tx, from) {
+			return i
+		}
+	}
+	return len(lanes)
+}
+
+// splitCosmosTxs splits the txs of the iterator into the lanes, followed by
+// the default lane, keeping their order. A tx never goes to a lane before the
+// lanes of the previous txs of its signers.
+func splitCosmosTxs(lanes []Lane, iter sdkmempool.Iterator, signerExtractor sdkmempool.SignerExtractionAdapter) [][]sdk.Tx {
+	split := make([][]sdk.Tx, len(lanes)+1)
+	signerLanes := make(map[string]int)
+
+	for ; iter != nil; iter = iter.Next() {
+		tx := iter.Tx()
+		if tx == nil {
+			break
+		}
+
+		senders := txSenders(signerExtractor, tx)
+		lane := matchCosmosLane(lanes, tx)
+		for _, sender := range senders {
+			lane = max(lane, signerLanes[sender])
+		}
+		for _, sender := range senders {
+			signerLanes[sender] = lane
+		}
+		split[lane] = append(split[lane], tx)
+	}
+	return split
+}
+
+func matchCosmosLane(lanes []Lane, tx sdk.Tx) int {
+	for i, lane := range lanes {
+		if lane.Matcher.MatchCosmosTx(tx) {
+			return i
+		}
+	}
+	return len(lanes)
+}
+
+// laneTxs are the txs of a lane selected for a block.
+type laneTxs struct {
+	name string
+	iter sdkmempool.Iterator
+	// maxGas is the max gas of the txs of the lane, or 0 if the lane is not
+	// limited, and gas the gas of the txs selected so far
+	maxGas uint64
+	gas    uint64
+}
+
+var _ sdkmempool.Iterator = &laneTxIterator{}
+
+// laneTxIterator returns the txs of the lanes in turn. The txs exceeding the
+// max gas of their lane are skipped along with the later txs of their senders
+// in all lanes, since their nonces would be gapped. The gas of a tx counts
+// towards its lane only once the tx selector selected it, since the proposal
+// handler may skip the txs it returns.
+type laneTxIterator struct {
+	lanes           []*laneTxs
+	signerExtractor sdkmempool.SignerExtractionAdapter
+	skipped         map[string]struct{}
+	logger          log.Logger
+
+	// gas is the gas of the current tx, until it is selected
+	gas uint64
+}
+
+// Tx returns the current transaction from the iterator.
+func (i *laneTxIterator) Tx() sdk.Tx {
+	return i.lanes[0].iter.Tx()
+}
+
+// Next advances the iterator to the next transaction, in the current lane or
+// the following ones. Returns nil when no more transactions are available.
+func (i *laneTxIterator) Next() sdkmempool.Iterator {
+	i.lanes[0].iter = i.lanes[0].iter.Next()
+	if !i.resolve() {
+		return nil
+	}
+	return i
+}
+
+// resolve moves to the next tx which fits in the max gas of its lane. It
+// returns false if there is none.
+func (i *laneTxIterator) resolve() bool {
+	for len(i.lanes) > 0 {
+		lane := i.lanes[0]
+		if lane.iter == nil || lane.iter.Tx() == nil {
+			i.lanes = i.lanes[1:]
+			continue
+		}
+
+		tx := lane.iter.Tx()
+		senders := txSenders(i.signerExtractor, tx)
+		if i.isSkipped(senders) {
+			lane.iter = lane.iter.Next()
+			continue
+		}
+
+		var gas uint64
+		if gasTx, ok := tx.(baseapp.GasTx); ok {
+			gas = gasTx.GetGas()
+		}
+		if lane.maxGas > 0 && lane.gas+gas > lane.maxGas {
+			i.logger.Debug("skipping tx exceeding the max gas of its lane", "lane", lane.name, "gas", gas, "lane_gas", lane.gas)
+			for _, sender := range senders {
+				i.skipped[sender] = struct{}{}
+			}
+			lane.iter = lane.iter.Next()
+			continue
+		}
+
+		i.gas = gas
+		return true
+	}
+	return false
+}
+
+// selected adds the gas of the current tx to its lane.
+func (i *laneTxIterator) selected() {
+	if len(i.lanes) == 0 {
+		return
+	}
+	i.lanes[0].gas += i.gas
+	i.gas = 0
+}
+
+func (i *laneTxIterator) isSkipped(senders []string) bool {
+	for _, sender := range senders {
+		if _, ok := i.skipped[sender]; ok {
+			return true
+		}
+	}
+	return false
+}
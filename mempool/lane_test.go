@@ -0,0 +1,186 @@
+package mempool
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/mempool/txpool"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// laneTx creates a signed EVM tx calling to, first seen at the time.
+func laneTx(t *testing.T, key *ethsecp256k1.PrivKey, nonce uint64, tip int64, to common.Address, seen time.Time) *txpool.LazyTransaction {
+	t.Helper()
+	ecdsaKey, err := key.ToECDSA()
+	require.NoError(t, err)
+
+	chainID := vmtypes.GetEthChainConfig().ChainID
+	signed, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		To:        &to,
+		Gas:       testGas,
+		GasFeeCap: big.NewInt(tip),
+		GasTipCap: big.NewInt(tip),
+	}), ethtypes.LatestSignerForChainID(chainID), ecdsaKey)
+	require.NoError(t, err)
+
+	return &txpool.LazyTransaction{
+		Hash:      signed.Hash(),
+		Tx:        signed,
+		Time:      seen,
+		GasFeeCap: uint256.MustFromBig(signed.GasFeeCap()),
+		GasTipCap: uint256.MustFromBig(signed.GasTipCap()),
+		Gas:       signed.Gas(),
+	}
+}
+
+func TestLane_Validate(t *testing.T) {
+	matcher := MatchContracts()
+	tests := []struct {
+		name    string
+		lanes   []Lane
+		errText string
+	}{
+		{"valid", []Lane{{Name: "oracle", Matcher: matcher, MaxBlockGasShare: sdkmath.LegacyNewDecWithPrec(2, 1)}, {Name: "fcfs", Matcher: matcher, Ordering: OrderByArrival}}, ""},
+		{"without name", []Lane{{Matcher: matcher}}, "lane name cannot be empty"},
+		{"default name", []Lane{{Name: defaultLaneName, Matcher: matcher}}, "is reserved"},
+		{"without matcher", []Lane{{Name: "oracle"}}, "matcher cannot be nil"},
+		{"unknown ordering", []Lane{{Name: "oracle", Matcher: matcher, Ordering: 2}}, "unknown ordering"},
+		{"share above one", []Lane{{Name: "oracle", Matcher: matcher, MaxBlockGasShare: sdkmath.LegacyNewDec(2)}}, "max block gas share must be between 0 and 1"},
+		{"negative share", []Lane{{Name: "oracle", Matcher: matcher, MaxBlockGasShare: sdkmath.LegacyNewDec(-1)}}, "max block gas share must be between 0 and 1"},
+		{"duplicate lane", []Lane{{Name: "oracle", Matcher: matcher}, {Name: "oracle", Matcher: matcher}}, "duplicate lane oracle"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateLanes(tc.lanes)
+			if tc.errText == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errText)
+		})
+	}
+}
+
+func TestLaneMatchers(t *testing.T) {
+	txConfig, _ := setupIteratorTest(t)
+	addr, key := newAddrKey(t)
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000100")
+
+	evmTx := laneTx(t, key, 0, 1_000_000_000, contract, time.Now()).Tx
+	cosmosTx := buildCosmosTx(t, txConfig, key, 1_000_000_000, testGas, testBondDenom)
+
+	bankSend := MatchMsgTypes(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.True(t, bankSend.MatchCosmosTx(cosmosTx))
+	require.False(t, bankSend.MatchEVMTx(evmTx, addr))
+	require.True(t, MatchMsgTypes(evmMsgTypeURL).MatchEVMTx(evmTx, addr))
+
+	require.True(t, MatchContracts(contract).MatchEVMTx(evmTx, addr))
+	require.False(t, MatchContracts(addr).MatchEVMTx(evmTx, addr))
+	require.False(t, MatchContracts(contract).MatchCosmosTx(cosmosTx))
+
+	require.True(t, MatchSenders(addr).MatchEVMTx(evmTx, addr))
+	require.True(t, MatchSenders(addr).MatchCosmosTx(cosmosTx))
+	require.False(t, MatchSenders(contract).MatchCosmosTx(cosmosTx))
+}
+
+func TestLaneIterator(t *testing.T) {
+	txConfig, b := setupIteratorTest(t)
+	oracle := common.HexToAddress("0x0000000000000000000000000000000000000100")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000200")
+	now := time.Now()
+
+	highOracleAddr, highOracleKey := newAddrKey(t)
+	midOracleAddr, midOracleKey := newAddrKey(t)
+	lowOracleAddr, lowOracleKey := newAddrKey(t)
+	firstAddr, firstKey := newAddrKey(t)
+	secondAddr, secondKey := newAddrKey(t)
+	defaultAddr, defaultKey := newAddrKey(t)
+	_, cosmosKey := newAddrKey(t)
+
+	highOracle := laneTx(t, highOracleKey, 0, 3_000_000_000, oracle, now)
+	midOracle := laneTx(t, midOracleKey, 0, 2_000_000_000, oracle, now)
+	lowOracle := laneTx(t, lowOracleKey, 0, 1_000_000_000, oracle, now)
+	// follows a tx skipped by the oracle lane
+	lowOracleLater := laneTx(t, lowOracleKey, 1, 9_000_000_000, other, now)
+	first := laneTx(t, firstKey, 0, 1_000_000_000, other, now)
+	second := laneTx(t, secondKey, 0, 5_000_000_000, other, now.Add(time.Second))
+	defaultTx := laneTx(t, defaultKey, 0, 2_000_000_000, other, now)
+	// follows a tx of the default lane, so it is kept after it
+	defaultOracle := laneTx(t, defaultKey, 1, 2_000_000_000, oracle, now)
+
+	pending := map[common.Address][]*txpool.LazyTransaction{
+		highOracleAddr: {highOracle},
+		midOracleAddr:  {midOracle},
+		lowOracleAddr:  {lowOracle, lowOracleLater},
+		firstAddr:      {first},
+		secondAddr:     {second},
+		defaultAddr:    {defaultTx, defaultOracle},
+	}
+	cosmosTx := buildCosmosTx(t, txConfig, cosmosKey, 4_000_000_000, testGas, testBondDenom)
+
+	mp := &Mempool{
+		lanes: []Lane{
+			{Name: "oracle", Matcher: MatchContracts(oracle), MaxBlockGasShare: sdkmath.LegacyNewDecWithPrec(5, 1)},
+			{Name: "fcfs", Matcher: MatchSenders(firstAddr, secondAddr), Ordering: OrderByArrival},
+		},
+		// the oracle lane fits two txs
+		blockGasLimit:   5 * testGas,
+		signerExtractor: NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+		logger:          log.NewNopLogger(),
+		txConfig:        txConfig,
+		blockchain:      b,
+	}
+	// laneHashes returns the hashes of the txs returned by the iterator,
+	// selecting all of them but the rejected one
+	laneHashes := func(rejected common.Hash) []common.Hash {
+		iter := mp.laneIterator(pending, insertCosmosTxs(t, newCosmosPriorityPool(), cosmosTx), nil, nil)
+		require.NotNil(t, iter)
+
+		var hashes []common.Hash
+		for ; iter != nil; iter = iter.Next() {
+			hash := common.Hash{}
+			if tx := iter.Tx(); !isCosmosTx(tx) {
+				hash = tx.GetMsgs()[0].(*vmtypes.MsgEthereumTx).Hash()
+			}
+			if hash != rejected || hash == (common.Hash{}) {
+				mp.laneTxSelected()
+			}
+			hashes = append(hashes, hash)
+		}
+		return hashes
+	}
+
+	require.Equal(t, []common.Hash{
+		highOracle.Hash, midOracle.Hash,
+		first.Hash, second.Hash,
+		{}, defaultTx.Hash, defaultOracle.Hash,
+	}, laneHashes(common.Hash{}))
+
+	// the gas of a tx rejected by the proposal handler leaves room in the
+	// lane, and the later tx of the sender of the included tx follows it
+	require.Equal(t, []common.Hash{
+		highOracle.Hash, midOracle.Hash, lowOracle.Hash,
+		first.Hash, second.Hash,
+		lowOracleLater.Hash, {}, defaultTx.Hash, defaultOracle.Hash,
+	}, laneHashes(highOracle.Hash))
+
+	// without txs there is no iterator
+	require.Nil(t, mp.laneIterator(nil, nil, nil, nil))
+}
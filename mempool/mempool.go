@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/holiman/uint256"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/metric"
@@ -103,6 +106,26 @@ type Config struct {
 	// EnableTxTracker controls whether the mempool records per-tx lifecycle
 	// telemetry (queued/pending/included latencies). Defaults to false.
 	EnableTxTracker bool
+	// JournalPath is the file the pooled txs are journaled to, so that they
+	// survive node restarts. The journal is disabled if empty.
+	JournalPath string
+	// JournalInterval is the time interval to regenerate the journal from the
+	// pooled txs.
+	JournalInterval time.Duration
+	// JournalTxs selects the txs which are journaled, one of JournalTxsAll,
+	// JournalTxsEVM or JournalTxsCosmos. Defaults to all txs.
+	JournalTxs string
+	// Lanes split the blocks built from the mempool into lanes with their own
+	// txs, ordering and share of the block gas, see Lane. The txs matching no
+	// lane are included last, ordered by fee.
+	Lanes []Lane
+	// CosmosPriceBump is the minimum fee bump percentage for a cosmos tx to
+	// replace a pooled tx with the same signer sequence. Defaults to
+	// DefaultCosmosPriceBump.
+	CosmosPriceBump uint64
+	// CosmosAccountSlots is the maximum number of pooled cosmos txs signed by
+	// an account. Defaults to DefaultCosmosAccountSlots.
+	CosmosAccountSlots uint64
 }
 
 // Mempool is an application side mempool implementation that operates
@@ -142,6 +165,23 @@ type Mempool struct {
 
 	/** Signer extraction **/
 	signerExtractor sdkmempool.SignerExtractionAdapter
+
+	/** Bundles and private txs **/
+	bundles    *bundlePool
+	privateTxs *privateTxPool
+
+	/** Block Building Lanes **/
+	lanes []Lane
+	// laneTxs is the lane iterator of the proposal being built, if any
+	laneTxs atomic.Pointer[laneTxIterator]
+
+	/** Transaction Journal **/
+	journal           *txJournal
+	journalEVM        bool
+	journalCosmos     bool
+	journalInterval   time.Duration
+	journalShutdownCh chan struct{}
+	journalWG         sync.WaitGroup
 }
 
 func NewMempool(
@@ -152,6 +192,8 @@ func NewMempool(
 	txConfig client.TxConfig,
 	evmRechecker legacypool.Rechecker,
 	cosmosRechecker Rechecker,
+	bundleRechecker legacypool.Rechecker,
+	privateRechecker legacypool.Rechecker,
 	config *Config,
 	cosmosPoolMaxTx int,
 ) *Mempool {
@@ -162,6 +204,10 @@ func NewMempool(
 		panic("config must not be nil")
 	}
 
+	if err := validateLanes(config.Lanes); err != nil {
+		panic(err)
+	}
+
 	if config.BlockGasLimit == 0 {
 		logger.Warn("block gas limit is 0, setting to fallback", "fallback_limit", fallbackBlockGasLimit)
 		config.BlockGasLimit = fallbackBlockGasLimit
@@ -208,6 +254,15 @@ func NewMempool(
 
 	reservationHandle := reservationTracker.NewHandle(cosmosReserverHandlerID, reserver.WithRefCounter())
 
+	cosmosPriceBump := config.CosmosPriceBump
+	if cosmosPriceBump == 0 {
+		cosmosPriceBump = DefaultCosmosPriceBump
+	}
+	cosmosAccountSlots := config.CosmosAccountSlots
+	if cosmosAccountSlots == 0 {
+		cosmosAccountSlots = DefaultCosmosAccountSlots
+	}
+
 	recheckPool := NewRecheckMempool(
 		config.CosmosPoolConfig,
 		cosmosPoolMaxTx,
@@ -217,6 +272,8 @@ func NewMempool(
 		reapList,
 		blockchain,
 		logger,
+		WithPriceBump(cosmosPriceBump),
+		WithAccountSlots(cosmosAccountSlots),
 	)
 
 	mempool := &Mempool{
@@ -233,13 +290,29 @@ func NewMempool(
 		reapList:                 reapList,
 		txTracker:                txTracker,
 		signerExtractor:          NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+		bundles:                  newBundlePool(bundleRechecker, legacyPooled(legacyPool), blockchain, NewTxEncoder(txConfig), logger),
+		privateTxs:               newPrivateTxPool(privateRechecker, legacyPending(legacyPool), blockchain, NewTxEncoder(txConfig), logger),
+		lanes:                    config.Lanes,
+	}
+
+	if config.JournalPath != "" {
+		mempool.journal = newTxJournal(config.JournalPath)
+		mempool.journalEVM = config.JournalTxs != JournalTxsCosmos
+		mempool.journalCosmos = config.JournalTxs != JournalTxsEVM
+		mempool.journalInterval = config.JournalInterval
+		if mempool.journalInterval <= 0 {
+			mempool.journalInterval = defaultJournalInterval
+		}
+		mempool.journalShutdownCh = make(chan struct{})
 	}
 
 	// Setup queues
 	mempool.evmInsertQueue = queue.New(
 		"evm",
 		func(txs []*ethtypes.Transaction) []error {
-			return txPool.Add(txs, AllowUnsafeSyncInsert)
+			errs := txPool.Add(txs, AllowUnsafeSyncInsert)
+			mempool.journalEVMTxs(txs, errs)
+			return errs
 		},
 		config.InsertQueueSize,
 	)
@@ -252,7 +325,9 @@ func NewMempool(
 				if tx == nil {
 					continue
 				}
-				errs[i] = recheckPool.Insert(context.Background(), *tx)
+				if errs[i] = recheckPool.Insert(context.Background(), *tx); errs[i] == nil {
+					mempool.journalCosmosTx(*tx)
+				}
 			}
 			return errs
 		},
@@ -277,6 +352,18 @@ func (m *Mempool) GetTxPool() *txpool.TxPool {
 	return m.txPool
 }
 
+// SubscribeTransactions registers a subscription for the EVM transactions
+// promoted to the pending pool.
+func (m *Mempool) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription {
+	return m.txPool.SubscribeTransactions(ch, reorgs)
+}
+
+// SubscribeDroppedTxs registers a subscription for the EVM transactions
+// dropped from the pool before being included in a block.
+func (m *Mempool) SubscribeDroppedTxs(ch chan<- legacypool.DroppedTxEvent) event.Subscription {
+	return m.legacyTxPool.SubscribeDroppedTxs(ch)
+}
+
 // SetClientCtx sets the client context provider for broadcasting transactions
 func (m *Mempool) SetClientCtx(clientCtx client.Context) {
 	m.clientCtx = clientCtx
@@ -394,17 +481,25 @@ func (m *Mempool) SelectBy(goCtx context.Context, txs [][]byte, filter func(sdk.
 }
 
 // buildIterator ensures that EVM mempool has checked txs for reorgs up to COMMITTED
-// block height and then returns a combined iterator over EVM & Cosmos txs.
+// block height and then returns a combined iterator over EVM & Cosmos txs,
+// split into the lanes of the mempool if any.
 func (m *Mempool) buildIterator(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
 	defer func(t0 time.Time) {
 		buildIteratorDuration.Record(ctx, float64(time.Since(t0).Milliseconds()))
 	}(time.Now())
 
-	evmIterator, cosmosIterator := m.getIterators(ctx, txs)
+	bundles := m.selectBundles(ctx)
+	if len(m.lanes) > 0 {
+		evmPending, cosmosIterator, baseFee := m.pendingTxs(ctx)
+		return m.laneIterator(withoutBundleTxs(evmPending, bundles), cosmosIterator, baseFee, bundles)
+	}
 
-	return NewEVMMempoolIterator(
+	evmIterator, cosmosIterator := m.getIterators(ctx, txs, bundles)
+
+	return newEVMMempoolIterator(
 		evmIterator,
 		cosmosIterator,
+		bundles,
 		m.logger,
 		m.txConfig,
 		m.blockchain,
@@ -473,6 +568,7 @@ func (m *Mempool) removeEVMTx(tx sdk.Tx, msgEthereumTx *evmtypes.MsgEthereumTx,
 	hash := msgEthereumTx.Hash()
 	if reason.Caller == sdkmempool.CallerRunTxFinalize {
 		_ = m.txTracker.IncludedInBlock(hash)
+		m.legacyTxPool.MarkIncluded(hash)
 		m.recordNonceAdvances(tx)
 	}
 
@@ -549,6 +645,12 @@ func (m *Mempool) SetEventBus(eventBus *cmttypes.EventBus) {
 // and it should update its internal data structures.
 func (m *Mempool) NotifyNewBlock() {
 	m.blockchain.NotifyNewBlock()
+	if m.bundles != nil {
+		m.bundles.prune(m.blockchain.CurrentBlock())
+	}
+	if m.privateTxs != nil {
+		m.privateTxs.prune(m.blockchain.CurrentBlock())
+	}
 	m.recheckCosmosPool.TriggerRecheck(m.blockchain.CurrentBlock())
 }
 
@@ -574,15 +676,36 @@ func (m *Mempool) Close() error {
 		errs = append(errs, fmt.Errorf("failed to close txpool: %w", err))
 	}
 
+	if m.journal != nil {
+		close(m.journalShutdownCh)
+		m.journalWG.Wait()
+		if err := m.journal.close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close journal: %w", err))
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
 // getIterators prepares iterators over pending EVM and Cosmos transactions.
 // It configures EVM transactions with proper base fee filtering and priority ordering,
-// while setting up the Cosmos iterator with the provided exclusion list.
-func (m *Mempool) getIterators(ctx context.Context, _ [][]byte) (evm *miner.TransactionsByPriceAndNonce, cosmos sdkmempool.Iterator) {
+// while setting up the Cosmos iterator with the provided exclusion list. The
+// pending EVM txs conflicting with the members of the bundles are dropped.
+func (m *Mempool) getIterators(ctx context.Context, _ [][]byte, bundles []selectedBundle) (evm *miner.TransactionsByPriceAndNonce, cosmos sdkmempool.Iterator) {
+	evmPending, cosmosIterator, baseFee := m.pendingTxs(ctx)
+	return miner.NewTransactionsByPriceAndNonce(nil, withoutBundleTxs(evmPending, bundles), baseFee), cosmosIterator
+}
+
+// pendingTxs returns the pending EVM txs filtered by the base fee, grouped by
+// sender, and an iterator over the pending Cosmos txs ordered by fee, along
+// with the base fee of the block.
+func (m *Mempool) pendingTxs(ctx context.Context) (
+	evm map[common.Address][]*txpool.LazyTransaction,
+	cosmos sdkmempool.Iterator,
+	baseFee *big.Int,
+) {
 	var (
-		evmIterator    *miner.TransactionsByPriceAndNonce
+		evmPending     map[common.Address][]*txpool.LazyTransaction
 		cosmosIterator sdkmempool.Iterator
 		wg             sync.WaitGroup
 	)
@@ -595,12 +718,12 @@ func (m *Mempool) getIterators(ctx context.Context, _ [][]byte) (evm *miner.Tran
 
 	// Keeper reads consume gas on the SDK context. Fetch these inputs once
 	// before starting goroutines so we do not race on the shared gas meters.
-	baseFee := m.vmKeeper.GetBaseFee(sdkctx)
+	baseFee = m.vmKeeper.GetBaseFee(sdkctx)
 	coinDenom := m.blockchain.GetCoinDenom()
 	cosmosBaseFee := currentBaseFee(m.blockchain)
 
 	wg.Go(func() {
-		evmIterator = m.evmIterator(ctx, selectHeight, baseFee)
+		evmPending = m.evmPendingTxs(ctx, selectHeight, baseFee)
 	})
 
 	wg.Go(func() {
@@ -609,32 +732,69 @@ func (m *Mempool) getIterators(ctx context.Context, _ [][]byte) (evm *miner.Tran
 
 	wg.Wait()
 
-	return evmIterator, cosmosIterator
+	return evmPending, cosmosIterator, baseFee
 }
 
-// evmIterator returns an iterator over the current valid txs in the evm
-// mempool at height.
-func (m *Mempool) evmIterator(ctx context.Context, height *big.Int, baseFee *big.Int) *miner.TransactionsByPriceAndNonce {
+// evmPendingTxs returns the current valid txs in the evm mempool at height,
+// grouped by sender.
+func (m *Mempool) evmPendingTxs(ctx context.Context, height *big.Int, baseFee *big.Int) map[common.Address][]*txpool.LazyTransaction {
+	if m.pendingTxProposalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.pendingTxProposalTimeout)
+		defer cancel()
+	}
+	return m.txPool.Rechecked(ctx, height, m.pendingFilter(baseFee))
+}
+
+// pendingFilter returns the filter of the evm txs selected for a block with
+// the base fee.
+func (m *Mempool) pendingFilter(baseFee *big.Int) txpool.PendingFilter {
 	var baseFeeUint *uint256.Int
 	if baseFee != nil {
 		baseFeeUint = uint256.MustFromBig(baseFee)
 	}
 
-	filter := txpool.PendingFilter{
+	return txpool.PendingFilter{
 		MinTip:       m.minTip,
 		BaseFee:      baseFeeUint,
 		BlobFee:      nil,
 		OnlyPlainTxs: true,
 		OnlyBlobTxs:  false,
 	}
+}
 
-	if m.pendingTxProposalTimeout > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, m.pendingTxProposalTimeout)
-		defer cancel()
+// PendingEVMTxs returns the evm txs of the pending block. The pending txs are
+// selected with the same filter and ordering as the txs of the
+// EVMMempoolIterator, on top of the latest block, until the block gas limit
+// is reached. A zero gasLimit does not limit the selection.
+func (m *Mempool) PendingEVMTxs(ctx context.Context, gasLimit uint64) ethtypes.Transactions {
+	var baseFee *big.Int
+	if fee := currentBaseFee(m.blockchain); fee != nil {
+		baseFee = fee.ToBig()
+	}
+
+	pending := m.txPool.Pending(ctx, m.pendingFilter(baseFee))
+	txs := miner.NewTransactionsByPriceAndNonce(nil, pending, baseFee)
+
+	var selected ethtypes.Transactions
+	gasLeft := gasLimit
+	for {
+		lazyTx, _ := txs.Peek()
+		if lazyTx == nil {
+			return selected
+		}
+		// skip the remaining txs of the sender, their nonces would be gapped
+		if lazyTx.Tx == nil || (gasLimit > 0 && lazyTx.Gas > gasLeft) {
+			txs.Pop()
+			continue
+		}
+
+		selected = append(selected, lazyTx.Tx)
+		if gasLimit > 0 {
+			gasLeft -= lazyTx.Gas
+		}
+		txs.Shift()
 	}
-	evmPendingTxs := m.txPool.Rechecked(ctx, height, filter)
-	return miner.NewTransactionsByPriceAndNonce(nil, evmPendingTxs, baseFee)
 }
 
 // cosmosIterator returns an iterator over the current valid txs in the cosmos
@@ -572,6 +572,55 @@ func TestMempool_InsertSynchronous(t *testing.T) {
 	require.Contains(t, err.Error(), "insufficient funds", "error should indicate insufficient funds")
 }
 
+func TestMempool_PendingEVMTxs(t *testing.T) {
+	mp, s := setupMempoolWithAccounts(t, 3)
+	txConfig, bus, accounts := s.txConfig, s.eventBus, s.accounts
+	err := bus.PublishEventNewBlockHeader(cmttypes.EventDataNewBlockHeader{
+		Header: cmttypes.Header{
+			Height:  1,
+			Time:    time.Now(),
+			ChainID: strconv.Itoa(constants.EighteenDecimalsChainID),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mp.GetTxPool().Sync())
+
+	ctx := sdk.Context{}.WithContext(context.Background())
+	txs := []sdk.Tx{
+		createMsgEthereumTx(t, txConfig, accounts[0].key, 0, big.NewInt(2e8)),
+		createMsgEthereumTx(t, txConfig, accounts[0].key, 1, big.NewInt(2e8)),
+		createMsgEthereumTx(t, txConfig, accounts[1].key, 0, big.NewInt(3e8)),
+		// gapped, so it is queued and not pending
+		createMsgEthereumTx(t, txConfig, accounts[2].key, 1, big.NewInt(4e8)),
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.NoError(t, mp.GetTxPool().Sync())
+
+	hashes := func(txs []sdk.Tx) []common.Hash {
+		res := make([]common.Hash, len(txs))
+		for i, tx := range txs {
+			res[i] = tx.GetMsgs()[0].(*vmtypes.MsgEthereumTx).Hash()
+		}
+		return res
+	}
+	pendingHashes := func(gasLimit uint64) []common.Hash {
+		pending := mp.PendingEVMTxs(context.Background(), gasLimit)
+		res := make([]common.Hash, len(pending))
+		for i, tx := range pending {
+			res[i] = tx.Hash()
+		}
+		return res
+	}
+
+	// the txs are ordered by tip, then by nonce for a sender
+	require.Equal(t, hashes([]sdk.Tx{txs[2], txs[0], txs[1]}), pendingHashes(0))
+	// the selection stops at the gas limit
+	require.Equal(t, hashes([]sdk.Tx{txs[2], txs[0]}), pendingHashes(2*txGasLimit+1))
+	require.Empty(t, pendingHashes(txGasLimit-1))
+}
+
 func TestMempool_InsertMultiMsgEthereumTx(t *testing.T) {
 	mp, s := setupMempoolWithAccounts(t, 3)
 	txConfig, bus := s.txConfig, s.eventBus
@@ -816,6 +865,8 @@ func setupMempool(t *testing.T, numAccounts, insertQueueSize int) (*mempool.Memp
 	// Create mempool
 	evmRechecker := &MockRechecker{}
 	cosmosRechecker := &MockRechecker{}
+	bundleRechecker := &MockRechecker{}
+	privateRechecker := &MockRechecker{}
 	mp := mempool.NewMempool(
 		getCtxCallback,
 		log.NewNopLogger(),
@@ -824,6 +875,8 @@ func setupMempool(t *testing.T, numAccounts, insertQueueSize int) (*mempool.Memp
 		txConfig,
 		evmRechecker,
 		cosmosRechecker,
+		bundleRechecker,
+		privateRechecker,
 		config,
 		1000, // cosmos pool max tx
 	)
@@ -1192,6 +1245,11 @@ func (mr *MockRechecker) Update(ctx sdk.Context, _ *types.Header) {
 	mr.ctx = ctx
 }
 
+func (mr *MockRechecker) Branch(ctx sdk.Context, _ *types.Header) sdk.Context {
+	cached, _ := ctx.CacheContext()
+	return cached
+}
+
 // createTestCosmosTx creates a real Cosmos SDK transaction with the given signer
 func createTestCosmosTx(t *testing.T, txConfig client.TxConfig, key *ecdsa.PrivateKey, sequence uint64) sdk.Tx {
 	t.Helper()
@@ -84,12 +84,24 @@ func (s *txByPriceAndTime) Pop() interface{} {
 	return x
 }
 
+// txByTime orders the transactions of a txByPriceAndTime by the time they
+// were first seen only, regardless of their price.
+type txByTime struct {
+	*txByPriceAndTime
+}
+
+func (s txByTime) Less(i, j int) bool {
+	heads := *s.txByPriceAndTime
+	return heads[i].tx.Time.Before(heads[j].tx.Time)
+}
+
 // TransactionsByPriceAndNonce represents a set of transactions that can return
 // transactions in a profit-maximizing sorted order, while supporting removing
 // entire batches of transactions for non-executable accounts.
 type TransactionsByPriceAndNonce struct {
 	txs     map[common.Address][]*txpool.LazyTransaction // Per account nonce-sorted list of transactions
 	heads   txByPriceAndTime                             // Next transaction for each unique account (price heap)
+	order   heap.Interface                               // Heap ordering the heads, by price unless ordered by arrival
 	signer  types.Signer                                 // Signer for the set of transactions
 	baseFee *uint256.Int                                 // Current base fee
 }
@@ -100,6 +112,21 @@ type TransactionsByPriceAndNonce struct {
 // Note, the input map is reowned so the caller should not interact any more with
 // if after providing it to the constructor.
 func NewTransactionsByPriceAndNonce(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) *TransactionsByPriceAndNonce {
+	return newTransactionsByNonce(signer, txs, baseFee, false)
+}
+
+// NewTransactionsByArrivalAndNonce creates a transaction set that can retrieve
+// transactions in the order they were first seen, first come first served, in
+// a nonce-honouring way. The transactions which can't pay the base fee are
+// dropped like by NewTransactionsByPriceAndNonce.
+//
+// Note, the input map is reowned so the caller should not interact any more with
+// if after providing it to the constructor.
+func NewTransactionsByArrivalAndNonce(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) *TransactionsByPriceAndNonce {
+	return newTransactionsByNonce(signer, txs, baseFee, true)
+}
+
+func newTransactionsByNonce(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int, byArrival bool) *TransactionsByPriceAndNonce {
 	// Convert the basefee from header format to uint256 format
 	var baseFeeUint *uint256.Int
 	if baseFee != nil {
@@ -116,15 +143,20 @@ func NewTransactionsByPriceAndNonce(signer types.Signer, txs map[common.Address]
 		heads = append(heads, wrapped)
 		txs[from] = accTxs[1:]
 	}
-	heap.Init(&heads)
 
 	// Assemble and return the transaction set
-	return &TransactionsByPriceAndNonce{
+	t := &TransactionsByPriceAndNonce{
 		txs:     txs,
 		heads:   heads,
 		signer:  signer,
 		baseFee: baseFeeUint,
 	}
+	t.order = &t.heads
+	if byArrival {
+		t.order = txByTime{&t.heads}
+	}
+	heap.Init(t.order)
+	return t
 }
 
 // Peek returns the next transaction by price.
@@ -141,18 +173,18 @@ func (t *TransactionsByPriceAndNonce) Shift() {
 	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
 		if wrapped, err := newTxWithMinerFee(txs[0], acc, t.baseFee); err == nil {
 			t.heads[0], t.txs[acc] = wrapped, txs[1:]
-			heap.Fix(&t.heads, 0)
+			heap.Fix(t.order, 0)
 			return
 		}
 	}
-	heap.Pop(&t.heads)
+	heap.Pop(t.order)
 }
 
 // Pop removes the best transaction, *not* replacing it with the next one from
 // the same account. This should be used when a transaction cannot be executed
 // and hence all subsequent ones should be discarded from the same account.
 func (t *TransactionsByPriceAndNonce) Pop() {
-	heap.Pop(&t.heads)
+	heap.Pop(t.order)
 }
 
 // Empty returns if the price heap is empty. It can be used to check it simpler
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
@@ -240,6 +242,36 @@ func (_m *VMKeeperI) SetState(ctx types.Context, addr common.Address, key common
 	_m.Called(ctx, addr, key, value)
 }
 
+// SimulateV1 provides a mock function with given fields: ctx, req
+func (_m *VMKeeperI) SimulateV1(ctx context.Context, req *vmtypes.SimulateV1Request) (*vmtypes.SimulateV1Response, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateV1")
+	}
+
+	var r0 *vmtypes.SimulateV1Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *vmtypes.SimulateV1Request) (*vmtypes.SimulateV1Response, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *vmtypes.SimulateV1Request) *vmtypes.SimulateV1Response); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vmtypes.SimulateV1Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *vmtypes.SimulateV1Request) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewVMKeeperI creates a new instance of VMKeeperI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewVMKeeperI(t interface {
@@ -0,0 +1,252 @@
+package mempool
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+
+	"cosmossdk.io/log/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultPrivateTxBlocks is the number of blocks a private tx is held for
+	// if it has no max block number.
+	DefaultPrivateTxBlocks = 25
+	// maxPooledPrivateTxs is the maximum number of private txs held by the
+	// mempool.
+	maxPooledPrivateTxs = 1024
+)
+
+// privateTx is an EVM tx held by the private tx pool until its max block
+// number.
+type privateTx struct {
+	tx             *ethtypes.Transaction
+	from           common.Address
+	cosmosTx       sdk.Tx
+	maxBlockNumber uint64
+}
+
+// privateTxPool holds the private txs submitted to the mempool. The private
+// txs are neither added to the txpool nor to the reap list, so that they are
+// not broadcast to the peers, and are only returned by the iterators of the
+// proposals built by this node. They are simulated through the rechecker on
+// insertion, on top of the pending txs of their sender, and again when a block
+// is built.
+type privateTxPool struct {
+	mu  sync.Mutex
+	txs map[common.Hash]*privateTx
+
+	// pending returns the pending txs of the sender held by the EVM pool
+	pending func(from common.Address) []*ethtypes.Transaction
+
+	rechecker  legacypool.Rechecker
+	blockchain *Blockchain
+	encoder    *TxEncoder
+	logger     log.Logger
+}
+
+// newPrivateTxPool creates a private tx pool. It returns nil if the rechecker
+// is nil, which disables private txs. The txs are simulated on top of the
+// pending txs of their sender returned by pending, if set.
+func newPrivateTxPool(
+	rechecker legacypool.Rechecker,
+	pending func(from common.Address) []*ethtypes.Transaction,
+	blockchain *Blockchain,
+	encoder *TxEncoder,
+	logger log.Logger,
+) *privateTxPool {
+	if rechecker == nil {
+		return nil
+	}
+	return &privateTxPool{
+		txs:        make(map[common.Hash]*privateTx),
+		pending:    pending,
+		rechecker:  rechecker,
+		blockchain: blockchain,
+		encoder:    encoder,
+		logger:     logger.With("pool", "private"),
+	}
+}
+
+// add simulates the tx on top of the latest state and the pending txs of its
+// sender, held by the EVM pool and by the private pool, and adds it to the
+// pool. A zero max block number holds the tx for DefaultPrivateTxBlocks blocks.
+func (p *privateTxPool) add(tx *ethtypes.Transaction, maxBlockNumber uint64) error {
+	head := p.blockchain.CurrentBlock().Number.Uint64()
+	if maxBlockNumber == 0 {
+		maxBlockNumber = head + DefaultPrivateTxBlocks
+	}
+	if maxBlockNumber <= head {
+		return fmt.Errorf("%w: max block %d, latest %d", ErrPrivateTxExpired, maxBlockNumber, head)
+	}
+
+	from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return fmt.Errorf("recovering private tx %s sender: %w", tx.Hash(), err)
+	}
+	cosmosTx, err := p.encoder.EVMTxToCosmosTx(tx)
+	if err != nil {
+		return fmt.Errorf("converting private tx %s: %w", tx.Hash(), err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.txs[tx.Hash()]; ok {
+		return ErrPrivateTxKnown
+	}
+	if len(p.txs) >= maxPooledPrivateTxs {
+		return ErrPrivateTxPoolFull
+	}
+
+	// the private txs of the sender take the place of its pending txs with
+	// the same nonce, since they are included instead of them
+	preceding := make(map[uint64]*ethtypes.Transaction)
+	if p.pending != nil {
+		for _, pending := range p.pending(from) {
+			if pending.Nonce() < tx.Nonce() {
+				preceding[pending.Nonce()] = pending
+			}
+		}
+	}
+	for _, ptx := range p.txs {
+		if ptx.from != from {
+			continue
+		}
+		if ptx.tx.Nonce() == tx.Nonce() {
+			return fmt.Errorf("%w: %s", ErrPrivateTxNonceConflict, ptx.tx.Hash())
+		}
+		if ptx.tx.Nonce() < tx.Nonce() {
+			preceding[ptx.tx.Nonce()] = ptx.tx
+		}
+	}
+	txs := make([]*ethtypes.Transaction, 0, len(preceding)+1)
+	for _, nonce := range slices.Sorted(maps.Keys(preceding)) {
+		txs = append(txs, preceding[nonce])
+	}
+	if err := simulateTxs(p.rechecker, p.blockchain, append(txs, tx)); err != nil {
+		return err
+	}
+
+	p.txs[tx.Hash()] = &privateTx{tx: tx, from: from, cosmosTx: cosmosTx, maxBlockNumber: maxBlockNumber}
+	return nil
+}
+
+// cancel drops the tx sent by from from the pool. It returns false if the pool
+// holds no such tx.
+func (p *privateTxPool) cancel(hash common.Hash, from common.Address) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if ptx, ok := p.txs[hash]; !ok || ptx.from != from {
+		return false
+	}
+	delete(p.txs, hash)
+	return true
+}
+
+// prune drops the txs whose max block number is committed.
+func (p *privateTxPool) prune(head *ethtypes.Header) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for hash, ptx := range p.txs {
+		if ptx.maxBlockNumber <= head.Number.Uint64() {
+			delete(p.txs, hash)
+		}
+	}
+}
+
+// selectFor returns the txs which can be included in the block at height. The
+// txs of a sender are returned in nonce order as a bundle, which is not
+// included atomically. The txs are simulated again on top of the latest state,
+// and the ones which fail the simulation, e.g. since they were already
+// included, are dropped. The txs following a nonce gap, e.g. since the pending
+// txs of their sender are not included yet, are kept for a later block.
+func (p *privateTxPool) selectFor(height uint64, baseFee *big.Int) []selectedBundle {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bySender := make(map[common.Address][]*privateTx)
+	for _, ptx := range p.txs {
+		if height > ptx.maxBlockNumber {
+			continue
+		}
+		bySender[ptx.from] = append(bySender[ptx.from], ptx)
+	}
+
+	var selected []selectedBundle
+	for _, ptxs := range bySender {
+		slices.SortFunc(ptxs, func(a, b *privateTx) int {
+			return cmp.Compare(a.tx.Nonce(), b.tx.Nonce())
+		})
+		ethTxs := make([]*ethtypes.Transaction, len(ptxs))
+		for i, ptx := range ptxs {
+			ethTxs[i] = ptx.tx
+		}
+
+		n, err := simulatePrefix(p.rechecker, p.blockchain, ethTxs)
+		switch {
+		case errors.Is(err, ErrNonceGap):
+			p.logger.Debug("holding private tx", "tx_hash", ethTxs[n].Hash(), "err", err)
+		case err != nil:
+			p.logger.Debug("dropping private tx", "tx_hash", ethTxs[n].Hash(), "err", err)
+			delete(p.txs, ethTxs[n].Hash())
+		}
+		ptxs, ethTxs = ptxs[:n], ethTxs[:n]
+
+		// the txs which can't pay the base fee of this block, and the later
+		// txs of the sender, are left out
+		for i, tx := range ethTxs {
+			if _, err := tx.EffectiveGasTip(baseFee); err != nil {
+				ptxs, ethTxs = ptxs[:i], ethTxs[:i]
+				break
+			}
+		}
+		if len(ptxs) == 0 {
+			continue
+		}
+		tip, ok := bundleTip(&Bundle{Txs: ethTxs}, baseFee)
+		if !ok {
+			continue
+		}
+
+		txs := make([]sdk.Tx, len(ptxs))
+		for i, ptx := range ptxs {
+			txs[i] = ptx.cosmosTx
+		}
+		selected = append(selected, selectedBundle{hash: ethTxs[0].Hash(), txs: txs, ethTxs: ethTxs, tip: tip})
+	}
+	return selected
+}
+
+// InsertPrivateTx adds the EVM tx to the mempool without broadcasting it. The
+// tx is only included in the blocks proposed by this node, up to and including
+// the max block number. A zero max block number holds the tx for
+// DefaultPrivateTxBlocks blocks.
+func (m *Mempool) InsertPrivateTx(tx *ethtypes.Transaction, maxBlockNumber uint64) error {
+	if m.privateTxs == nil {
+		return ErrPrivateTxsDisabled
+	}
+	return m.privateTxs.add(tx, maxBlockNumber)
+}
+
+// CancelPrivateTx drops the private tx sent by from from the mempool. It
+// returns false if the mempool holds no such tx.
+func (m *Mempool) CancelPrivateTx(hash common.Hash, from common.Address) bool {
+	if m.privateTxs == nil {
+		return false
+	}
+	return m.privateTxs.cancel(hash, from)
+}
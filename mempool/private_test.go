@@ -0,0 +1,154 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testNonceRechecker simulates the txs against the nonces of their senders.
+type testNonceRechecker struct {
+	state   map[common.Address]uint64
+	nonces  map[common.Address]uint64
+	signer  ethtypes.Signer
+	failing map[common.Hash]bool
+}
+
+func (r *testNonceRechecker) GetContext() (sdk.Context, func()) { return sdk.Context{}, func() {} }
+
+func (r *testNonceRechecker) RecheckEVM(ctx sdk.Context, tx *ethtypes.Transaction) (sdk.Context, error) {
+	from, err := ethtypes.Sender(r.signer, tx)
+	if err != nil {
+		return ctx, err
+	}
+	switch {
+	case tx.Nonce() > r.nonces[from]:
+		return ctx, ErrNonceGap
+	case tx.Nonce() < r.nonces[from]:
+		return ctx, ErrNonceLow
+	case r.failing[tx.Hash()]:
+		return ctx, errors.New("simulation failed")
+	}
+	r.nonces[from]++
+	return ctx, nil
+}
+
+func (r *testNonceRechecker) Update(sdk.Context, *ethtypes.Header) {
+	r.nonces = make(map[common.Address]uint64)
+	for addr, nonce := range r.state {
+		r.nonces[addr] = nonce
+	}
+}
+
+func TestPrivateTxPool(t *testing.T) {
+	txConfig, b := setupIteratorTest(t)
+	b.latestCtx = sdk.Context{}.WithContext(context.Background())
+	rechecker := &testBundleRechecker{failing: make(map[common.Hash]bool)}
+	pool := newPrivateTxPool(rechecker, nil, b, NewTxEncoder(txConfig), log.NewNopLogger())
+	baseFee := big.NewInt(1_000_000_000)
+
+	_, key := newAddrKey(t)
+	_, otherKey := newAddrKey(t)
+	low := bundleTx(t, key, 0, 1_000_000_000)
+	high := bundleTx(t, otherKey, 0, 5_000_000_000)
+	failing := bundleTx(t, key, 2, 5_000_000_000)
+
+	// the txs without max block number are held for the default number of
+	// blocks on top of the genesis block
+	require.NoError(t, pool.add(low, 0))
+	require.Equal(t, uint64(DefaultPrivateTxBlocks), pool.txs[low.Hash()].maxBlockNumber)
+	require.ErrorIs(t, pool.add(low, 0), ErrPrivateTxKnown)
+	require.ErrorIs(t, pool.add(bundleTx(t, key, 0, 2_000_000_000), 0), ErrPrivateTxNonceConflict)
+	require.NoError(t, pool.add(high, 2))
+
+	rechecker.failing[failing.Hash()] = true
+	require.ErrorContains(t, pool.add(failing, 2), "simulation failed")
+
+	selected := pool.selectFor(1, baseFee)
+	require.Len(t, selected, 2)
+	require.Len(t, selected[0].txs, 1)
+
+	// the txs are only selected up to their max block number
+	selected = pool.selectFor(3, baseFee)
+	require.Len(t, selected, 1)
+	require.Equal(t, low.Hash(), selected[0].hash)
+
+	// a tx which fails the simulation is dropped
+	rechecker.failing[high.Hash()] = true
+	selected = pool.selectFor(1, baseFee)
+	require.Len(t, selected, 1)
+	require.NotContains(t, pool.txs, high.Hash())
+	delete(rechecker.failing, high.Hash())
+
+	// only the sender of a tx can cancel it
+	lowFrom, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(low.ChainId()), low)
+	require.NoError(t, err)
+	require.False(t, pool.cancel(low.Hash(), common.Address{0x01}))
+	require.True(t, pool.cancel(low.Hash(), lowFrom))
+	require.False(t, pool.cancel(low.Hash(), lowFrom))
+	require.Empty(t, pool.selectFor(1, baseFee))
+
+	// the txs whose max block is committed are pruned
+	require.NoError(t, pool.add(high, 2))
+	pool.prune(&ethtypes.Header{Number: big.NewInt(1)})
+	require.Contains(t, pool.txs, high.Hash())
+	pool.prune(&ethtypes.Header{Number: big.NewInt(2)})
+	require.Empty(t, pool.txs)
+}
+
+func TestPrivateTxPool_Nonces(t *testing.T) {
+	txConfig, b := setupIteratorTest(t)
+	b.latestCtx = sdk.Context{}.WithContext(context.Background())
+	rechecker := &testNonceRechecker{
+		state:   make(map[common.Address]uint64),
+		signer:  ethtypes.LatestSignerForChainID(b.Config().ChainID),
+		failing: make(map[common.Hash]bool),
+	}
+	addr, key := newAddrKey(t)
+	public := bundleTx(t, key, 0, 1_000_000_000)
+	pending := map[common.Address][]*ethtypes.Transaction{addr: {public}}
+	pool := newPrivateTxPool(rechecker, func(from common.Address) []*ethtypes.Transaction {
+		return pending[from]
+	}, b, NewTxEncoder(txConfig), log.NewNopLogger())
+	baseFee := big.NewInt(1_000_000_000)
+
+	// the txs follow the pending txs of their sender held by the EVM pool and
+	// by the private pool
+	first := bundleTx(t, key, 1, 1_000_000_000)
+	second := bundleTx(t, key, 2, 5_000_000_000)
+	require.NoError(t, pool.add(first, 0))
+	require.NoError(t, pool.add(second, 0))
+	require.ErrorIs(t, pool.add(bundleTx(t, key, 4, 1_000_000_000), 0), ErrNonceGap)
+
+	// the txs following the pending tx are held until it is included
+	require.Empty(t, pool.selectFor(1, baseFee))
+	require.Len(t, pool.txs, 2)
+
+	// the txs of the sender are then selected in nonce order
+	rechecker.state[addr] = 1
+	pending[addr] = nil
+	selected := pool.selectFor(2, baseFee)
+	require.Len(t, selected, 1)
+	require.Equal(t, []*ethtypes.Transaction{first, second}, selected[0].ethTxs)
+	require.Equal(t, uint64(3_000_000_000), selected[0].tip.Uint64())
+
+	// a failing tx is dropped, and the later txs of its sender are held
+	rechecker.failing[first.Hash()] = true
+	require.Empty(t, pool.selectFor(2, baseFee))
+	require.NotContains(t, pool.txs, first.Hash())
+	require.Contains(t, pool.txs, second.Hash())
+
+	// an included tx is dropped
+	rechecker.state[addr] = 3
+	require.Empty(t, pool.selectFor(2, baseFee))
+	require.Empty(t, pool.txs)
+}
@@ -1,10 +1,12 @@
 package mempool
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"sync"
 	"time"
 
@@ -59,6 +61,15 @@ func init() {
 	}
 }
 
+const (
+	// DefaultCosmosPriceBump is the minimum fee bump percentage for a cosmos
+	// tx to replace a pooled tx with the same signer sequence.
+	DefaultCosmosPriceBump = 10
+	// DefaultCosmosAccountSlots is the maximum number of pooled cosmos txs
+	// signed by an account.
+	DefaultCosmosAccountSlots = 16
+)
+
 // Rechecker defines the minimal set of methods needed to recheck cosmos
 // transactions and manage the context that the transactions are rechecked
 // against.
@@ -74,6 +85,10 @@ type Rechecker interface {
 
 	// Update updates the recheckers context to be the ctx at headers height.
 	Update(ctx sdk.Context, header *ethtypes.Header)
+
+	// Branch returns a branch of ctx set up like the context of Update, without
+	// updating the recheckers context.
+	Branch(ctx sdk.Context, header *ethtypes.Header) sdk.Context
 }
 
 // RecheckMempool wraps an ExtMempool and provides block-driven rechecking
@@ -111,9 +126,58 @@ type RecheckMempool struct {
 
 	reapList *reaplist.ReapList
 
+	// txPriority and txReplacement decide if a pooled tx is replaced by a new
+	// tx with the same signer sequence. Without a txReplacement, the new tx
+	// must bump the priority by at least priceBump percent.
+	txPriority    sdkmempool.TxPriority[math.Int]
+	txReplacement func(oldPriority, newPriority math.Int, oldTx, newTx sdk.Tx) bool
+	priceBump     uint64
+
+	// accountSlots caps the number of pooled txs signed by an account (0
+	// means unbounded), and maxTxs the number of pooled txs, past which the
+	// txs paying the lowest fee are evicted.
+	accountSlots uint64
+	maxTxs       int
+
+	// pooled indexes the pooled txs by their first signer and sequence, the
+	// way the ExtMempool identifies them, and slots counts the pooled txs of
+	// every signer.
+	pooled map[string]map[uint64]*pooledCosmosTx
+	slots  map[string]uint64
+
 	wg sync.WaitGroup
 }
 
+// pooledCosmosTx is a tx in the RecheckMempool, along with its priority.
+type pooledCosmosTx struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	signers  []string
+	priority math.Int
+}
+
+// RecheckMempoolOption configures a RecheckMempool.
+type RecheckMempoolOption func(*RecheckMempool)
+
+// WithPriceBump sets the minimum percentage a tx must bump the fee of a pooled
+// tx with the same signer sequence by to replace it. Without it, the new tx
+// must pay at least the fee of the pooled tx. It is ignored if the pool config
+// has a custom TxReplacement.
+func WithPriceBump(priceBump uint64) RecheckMempoolOption {
+	return func(m *RecheckMempool) {
+		m.priceBump = priceBump
+	}
+}
+
+// WithAccountSlots caps the number of pooled txs signed by an account, so that
+// an account can't crowd out the others.
+func WithAccountSlots(accountSlots uint64) RecheckMempoolOption {
+	return func(m *RecheckMempool) {
+		m.accountSlots = accountSlots
+	}
+}
+
 // NewRecheckMempool creates a new RecheckMempool.
 func NewRecheckMempool(
 	defaultCosmosPoolConfig *sdkmempool.PriorityNonceMempoolConfig[math.Int],
@@ -124,16 +188,17 @@ func NewRecheckMempool(
 	reapList *reaplist.ReapList,
 	blockchain *Blockchain,
 	logger log.Logger,
+	opts ...RecheckMempoolOption,
 ) *RecheckMempool {
 	signerExtractor := sdkmempool.NewDefaultSignerExtractionAdapter()
-	cosmosMempoolConfig := cosmosPoolConfig(
-		blockchain,
-		defaultCosmosPoolConfig,
-		maxTxs,
-		onTransactionReplace(reapList, signerExtractor, reserver, logger),
-	)
+	cosmosMempoolConfig := cosmosPoolConfig(blockchain, defaultCosmosPoolConfig, maxTxs)
 
-	return &RecheckMempool{
+	var txReplacement func(oldPriority, newPriority math.Int, oldTx, newTx sdk.Tx) bool
+	if defaultCosmosPoolConfig != nil {
+		txReplacement = defaultCosmosPoolConfig.TxReplacement
+	}
+
+	m := &RecheckMempool{
 		ExtMempool:      sdkmempool.NewPriorityMempool(cosmosMempoolConfig),
 		reserver:        reserver,
 		rechecker:       rechecker,
@@ -146,7 +211,16 @@ func NewRecheckMempool(
 		recheckShutdown: make(chan struct{}),
 		reapList:        reapList,
 		recheckedTxs:    recheckedTxs,
+		txPriority:      cosmosMempoolConfig.TxPriority,
+		txReplacement:   txReplacement,
+		maxTxs:          maxTxs,
+		pooled:          make(map[string]map[uint64]*pooledCosmosTx),
+		slots:           make(map[string]uint64),
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
 // Start begins the background recheck loop and initializes the rechecker's
@@ -175,6 +249,10 @@ func (m *RecheckMempool) Close() error {
 
 // Insert adds a transaction to the pool after running the ante handler.
 // This is the main entry point for new cosmos transactions.
+//
+// A tx with the same signer sequence as a pooled tx replaces it if it bumps
+// the fee enough. Once the pool is full, a new tx evicts the pooled tx paying
+// the lowest fee if it pays more.
 func (m *RecheckMempool) Insert(_ context.Context, tx sdk.Tx) (err error) {
 	// Reserve addresses to prevent conflicts with EVM pool
 	addrs, err := m.reserveTx(tx)
@@ -210,13 +288,44 @@ func (m *RecheckMempool) Insert(_ context.Context, tx sdk.Tx) (err error) {
 		ctx, write = m.rechecker.GetContext()
 	}
 
-	if _, err := m.rechecker.RecheckCosmos(ctx, tx); err != nil {
-		return fmt.Errorf("ante handler failed: %w", err)
+	ptx, err := m.newPooledTx(ctx, tx)
+	if err != nil {
+		return err
 	}
 
-	if err := m.ExtMempool.Insert(ctx, tx); err != nil {
-		return err
+	old := m.pooled[ptx.sender][ptx.nonce]
+	if old != nil {
+		if !m.shouldReplace(old, ptx) {
+			return fmt.Errorf("%w: sequence %d, fee priority %s, pooled fee priority %s",
+				ErrCosmosReplaceUnderpriced, ptx.nonce, ptx.priority, old.priority)
+		}
+		// the ctx holds the state changes of the pooled tx, so the
+		// replacement can't be checked on top of it. The Rechecker context
+		// is left as is, see recheckReplacement.
+		if err := m.recheckReplacement(old, ptx); err != nil {
+			return fmt.Errorf("ante handler failed: %w", err)
+		}
+		if err := m.replaceTx(ctx, old, ptx); err != nil {
+			return err
+		}
+	} else {
+		if err := m.checkAccountSlots(ptx); err != nil {
+			return err
+		}
+		if _, err := m.rechecker.RecheckCosmos(ctx, tx); err != nil {
+			return fmt.Errorf("ante handler failed: %w", err)
+		}
+		if m.maxTxs > 0 && m.ExtMempool.CountTx() >= m.maxTxs {
+			if err := m.evictFor(ptx); err != nil {
+				return err
+			}
+		}
+		if err := m.ExtMempool.Insert(ctx, tx); err != nil {
+			return err
+		}
+		write()
 	}
+	m.trackTx(ptx)
 
 	// since we have rechecked the tx via `rechecker.RecheckCosmos`, and this
 	// rechecks the tx on top of the state of all txs already rechecked in the
@@ -225,12 +334,230 @@ func (m *RecheckMempool) Insert(_ context.Context, tx sdk.Tx) (err error) {
 		m.logger.Error("successfully inserted cosmos tx, but failed to insert into reap list", "err", err)
 	}
 
-	write()
 	m.markTxInserted(tx)
 
 	return nil
 }
 
+// newPooledTx indexes tx by its first signer and sequence, the way the
+// ExtMempool identifies it.
+func (m *RecheckMempool) newPooledTx(ctx context.Context, tx sdk.Tx) (*pooledCosmosTx, error) {
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, errors.New("tx must have at least one signer")
+	}
+
+	nonce, err := sdkmempool.ChooseNonce(signers[0].Sequence, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx := &pooledCosmosTx{
+		tx:       tx,
+		sender:   string(signers[0].Signer),
+		nonce:    nonce,
+		signers:  make([]string, len(signers)),
+		priority: m.txPriority.GetTxPriority(ctx, tx),
+	}
+	for i, s := range signers {
+		ptx.signers[i] = string(s.Signer)
+	}
+	return ptx, nil
+}
+
+// shouldReplace reports whether ptx replaces the pooled tx old with the same
+// signer sequence. Unless the pool config has a custom TxReplacement, ptx must
+// bump the fee priority by at least the price bump percentage.
+func (m *RecheckMempool) shouldReplace(old, ptx *pooledCosmosTx) bool {
+	if m.txReplacement != nil {
+		return m.txReplacement(old.priority, ptx.priority, old.tx, ptx.tx)
+	}
+
+	// new priority * 100 >= old priority * (100 + price bump)
+	threshold := old.priority.Mul(math.NewIntFromUint64(100 + m.priceBump))
+	return m.txPriority.Compare(ptx.priority.MulRaw(100), threshold) >= 0
+}
+
+// checkAccountSlots returns an error if a signer of ptx has no slots left.
+func (m *RecheckMempool) checkAccountSlots(ptx *pooledCosmosTx) error {
+	if m.accountSlots == 0 {
+		return nil
+	}
+	for _, signer := range ptx.signers {
+		if m.slots[signer] >= m.accountSlots {
+			return fmt.Errorf("%w: %s has %d pooled txs",
+				ErrCosmosAccountSlotsFull, sdk.AccAddress(signer), m.slots[signer])
+		}
+	}
+	return nil
+}
+
+// recheckReplacement checks the replacement ptx of the pooled tx old on a
+// branch of the latest chain state set up by the Rechecker, on top of the
+// pooled txs of the same signer with a lower sequence. The txs of the other
+// signers are not rechecked.
+//
+// NOTE: the context of the Rechecker keeps the state changes of old, such as
+// its fee deduction, until the next recheck rebuilds it. Until then, the later
+// txs of the signers of old are checked against their balance net of the fee
+// of old rather than of ptx.
+func (m *RecheckMempool) recheckReplacement(old, ptx *pooledCosmosTx) error {
+	latestCtx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return fmt.Errorf("fetching latest context: %w", err)
+	}
+	ctx := m.rechecker.Branch(latestCtx, m.blockchain.CurrentBlock())
+
+	prev := make([]*pooledCosmosTx, 0, len(m.pooled[old.sender]))
+	for nonce, pooled := range m.pooled[old.sender] {
+		if nonce < old.nonce {
+			prev = append(prev, pooled)
+		}
+	}
+	slices.SortFunc(prev, func(a, b *pooledCosmosTx) int {
+		return cmp.Compare(a.nonce, b.nonce)
+	})
+	for _, pooled := range prev {
+		// the txs failing here are removed by the next recheck
+		newCtx, err := m.rechecker.RecheckCosmos(ctx, pooled.tx)
+		if err != nil {
+			break
+		}
+		ctx = newCtx
+	}
+
+	_, err = m.rechecker.RecheckCosmos(ctx, ptx.tx)
+	return err
+}
+
+// replaceTx swaps the pooled tx old for ptx in the ExtMempool, and drops old
+// from the reap list and the reservations.
+func (m *RecheckMempool) replaceTx(ctx context.Context, old, ptx *pooledCosmosTx) error {
+	// the old tx is removed first, so that a full pool does not reject the
+	// replacement
+	if err := m.ExtMempool.Remove(old.tx); err != nil {
+		return fmt.Errorf("removing replaced tx: %w", err)
+	}
+	if err := m.ExtMempool.Insert(ctx, ptx.tx); err != nil {
+		if errInsert := m.ExtMempool.Insert(ctx, old.tx); errInsert != nil {
+			m.logger.Error("failed to reinsert replaced tx", "err", errInsert)
+			m.dropTx(old)
+		}
+		return err
+	}
+
+	m.dropTx(old)
+	return nil
+}
+
+// evictFor makes room in the full pool for ptx, by evicting the pooled tx
+// paying the lowest fee if ptx pays more. Only the last pooled tx of a signer
+// can be evicted, so that no pooled tx is left behind a sequence gap, and
+// never one of a signer of ptx.
+func (m *RecheckMempool) evictFor(ptx *pooledCosmosTx) error {
+	var victim *pooledCosmosTx
+	for _, txs := range m.pooled {
+		var last *pooledCosmosTx
+		for _, pooled := range txs {
+			if last == nil || pooled.nonce > last.nonce {
+				last = pooled
+			}
+		}
+		if sharesSigner(last, ptx) || m.txPriority.Compare(last.priority, ptx.priority) >= 0 {
+			continue
+		}
+		if victim == nil || m.txPriority.Compare(last.priority, victim.priority) < 0 {
+			victim = last
+		}
+	}
+	if victim == nil {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	if err := m.ExtMempool.Remove(victim.tx); err != nil {
+		return fmt.Errorf("evicting tx: %w", err)
+	}
+	m.dropTx(victim)
+
+	// the txs rechecked on top of the evicted tx are dropped from the
+	// snapshot, and rebuilt by the next recheck
+	m.recheckedTxs.Do(func(store *CosmosTxStore) { store.InvalidateFrom(victim.tx) })
+	return nil
+}
+
+func sharesSigner(a, b *pooledCosmosTx) bool {
+	for _, x := range a.signers {
+		for _, y := range b.signers {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dropTx drops a tx removed from the ExtMempool from the index, the reap list
+// and the reservations.
+func (m *RecheckMempool) dropTx(ptx *pooledCosmosTx) {
+	m.untrackTx(ptx)
+	m.reapList.DropCosmosTx(ptx.tx)
+	if err := m.unreserveTx(ptx.tx); err != nil {
+		m.logger.Error("failed to release reservations", "err", err)
+	}
+}
+
+// trackTx adds a tx inserted into the ExtMempool to the index.
+func (m *RecheckMempool) trackTx(ptx *pooledCosmosTx) {
+	txs, ok := m.pooled[ptx.sender]
+	if !ok {
+		txs = make(map[uint64]*pooledCosmosTx)
+		m.pooled[ptx.sender] = txs
+	}
+	txs[ptx.nonce] = ptx
+	for _, signer := range ptx.signers {
+		m.slots[signer]++
+	}
+}
+
+// untrackTx removes a tx removed from the ExtMempool from the index.
+func (m *RecheckMempool) untrackTx(ptx *pooledCosmosTx) {
+	txs := m.pooled[ptx.sender]
+	if txs[ptx.nonce] != ptx {
+		return
+	}
+	delete(txs, ptx.nonce)
+	if len(txs) == 0 {
+		delete(m.pooled, ptx.sender)
+	}
+	for _, signer := range ptx.signers {
+		if m.slots[signer] <= 1 {
+			delete(m.slots, signer)
+			continue
+		}
+		m.slots[signer]--
+	}
+}
+
+// pooledTx returns the index entry of a pooled tx, or nil if it is not pooled.
+func (m *RecheckMempool) pooledTx(tx sdk.Tx) *pooledCosmosTx {
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil || len(signers) == 0 {
+		return nil
+	}
+	nonce, err := sdkmempool.ChooseNonce(signers[0].Sequence, tx)
+	if err != nil {
+		return nil
+	}
+	ptx := m.pooled[string(signers[0].Signer)][nonce]
+	if ptx == nil || ptx.tx != tx {
+		return nil
+	}
+	return ptx
+}
+
 // Remove is a noop for this pool. All removals are processed during the async
 // recheck loop.
 func (m *RecheckMempool) Remove(tx sdk.Tx) error {
@@ -526,6 +853,9 @@ func (m *RecheckMempool) runRecheck(done chan struct{}, newHead *ethtypes.Header
 			m.logger.Error("failed to remove tx during recheck", "err", err)
 			continue
 		}
+		if ptx := m.pooledTx(txn); ptx != nil {
+			m.untrackTx(ptx)
+		}
 		m.reapList.DropCosmosTx(txn)
 
 		if err := m.unreserveTx(txn); err != nil {
@@ -569,7 +899,6 @@ func cosmosPoolConfig(
 	blockchain *Blockchain,
 	defaultConfig *sdkmempool.PriorityNonceMempoolConfig[math.Int],
 	maxTxs int,
-	onReplace func(oldTx, newTx sdk.Tx),
 ) sdkmempool.PriorityNonceMempoolConfig[math.Int] {
 	var config sdkmempool.PriorityNonceMempoolConfig[math.Int]
 
@@ -599,49 +928,12 @@ func cosmosPoolConfig(
 		}
 	}
 
-	config.TxReplacement = func(oldPriority, newPriority math.Int, oldTx, newTx sdk.Tx) bool {
-		shouldReplace := true
-
-		if defaultConfig != nil && defaultConfig.TxReplacement != nil {
-			// if the default config has a custom TxReplacement function, call
-			// that to determine if we should replace oldTx for newTx
-			shouldReplace = defaultConfig.TxReplacement(oldPriority, newPriority, oldTx, newTx)
-		}
-
-		if shouldReplace {
-			onReplace(oldTx, newTx)
-		}
-
-		return shouldReplace
-	}
-
+	// replacements are handled by the RecheckMempool, which removes the
+	// pooled tx before inserting the one replacing it
 	config.MaxTx = maxTxs
 	return config
 }
 
-func onTransactionReplace(
-	reapList *reaplist.ReapList,
-	signerExtractor sdkmempool.SignerExtractionAdapter,
-	reserver *reserver.ReservationHandle,
-	logger log.Logger,
-) func(oldTx, newTx sdk.Tx) {
-	return func(oldTx, _ sdk.Tx) {
-		// tx is being replaced, we need to drop the tx that is going to be removed
-		// from the reap list. we assume that the tx doing the replacing has
-		// already been inserted into the reaplist via the insert.
-		reapList.DropCosmosTx(oldTx)
-
-		addrs, err := extractEVMAddresses(signerExtractor, oldTx)
-		if err != nil {
-			return
-		}
-
-		if err := reserver.Release(addrs...); err != nil {
-			logger.Error("Failed to release reservations (onTransactionReplace)", "err", err, "addrs", addrs)
-		}
-	}
-}
-
 func extractEVMAddresses(extractor sdkmempool.SignerExtractionAdapter, tx sdk.Tx) ([]common.Address, error) {
 	signers, err := extractor.GetSigners(tx)
 	if err != nil {
@@ -48,6 +48,8 @@ import (
 type mockRechecker struct {
 	ctx         sdk.Context
 	anteHandler sdk.AnteHandler
+	// branches counts the calls to Branch
+	branches int
 }
 
 func newMockRechecker(ctx sdk.Context, anteHandler sdk.AnteHandler) *mockRechecker {
@@ -72,6 +74,12 @@ func (m *mockRechecker) Update(ctx sdk.Context, _ *ethtypes.Header) {
 	m.ctx = ctx
 }
 
+func (m *mockRechecker) Branch(ctx sdk.Context, _ *ethtypes.Header) sdk.Context {
+	m.branches++
+	cached, _ := ctx.CacheContext()
+	return cached
+}
+
 // ----------------------------------------------------------------------------
 // Test Blockchain
 // ----------------------------------------------------------------------------
@@ -1158,6 +1166,197 @@ func TestRecheckMempool_ReplacementDropsFromReapList(t *testing.T) {
 	require.Equal(t, expected, reaped[0])
 }
 
+func TestRecheckMempool_ReplaceByFee(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	tracker := reserver.NewReservationTracker()
+	handle := tracker.NewHandle(1, reserver.WithRefCounter())
+	bc := newTestBlockchain(t, ctx)
+	rc := mempool.NewTxRechecker(newStoreNonceAnteHandler(storeKey), nil)
+	reapList := newTestReapList()
+
+	mp := mempool.NewRecheckMempool(
+		nil, 0, handle, rc,
+		newTestRecheckedTxs(), reapList, bc, log.NewNopLogger(),
+		mempool.WithPriceBump(10),
+	)
+	mp.Start(testHeader(0))
+	t.Cleanup(func() { require.NoError(t, mp.Close()) })
+
+	acc := newRecheckTestAccount(t)
+	tx0 := newRecheckTestTxWithGasPrice(t, acc.key, 0, 100)
+	tx1 := newRecheckTestTxWithGasPrice(t, acc.key, 1, 100)
+	require.NoError(t, mp.Insert(ctx, tx0))
+	require.NoError(t, mp.Insert(ctx, tx1))
+
+	// the fee must be bumped by at least 10%
+	err := mp.Insert(ctx, newRecheckTestTxWithGasPrice(t, acc.key, 0, 109))
+	require.ErrorIs(t, err, mempool.ErrCosmosReplaceUnderpriced)
+
+	// the replacement is checked without the state changes of tx0
+	replacement := newRecheckTestTxWithGasPrice(t, acc.key, 0, 110)
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, []sdk.Tx{replacement, tx1}, collectIteratorTxs(mp.Select(context.Background(), nil)))
+	require.Empty(t, collectIteratorTxs(mp.RecheckedTxs(context.Background(), big.NewInt(0))))
+
+	// the txs following the replacement are still valid
+	tx2 := newRecheckTestTxWithGasPrice(t, acc.key, 2, 100)
+	require.NoError(t, mp.Insert(ctx, tx2))
+	require.Len(t, reapList.Reap(0, 0), 3)
+	require.True(t, tracker.NewHandle(2).Has(acc.address))
+
+	// the snapshot is rebuilt from the replacement by the next recheck
+	mp.TriggerRecheckSync(testHeader(1))
+	rechecked := collectIteratorTxs(mp.RecheckedTxs(context.Background(), big.NewInt(1)))
+	require.Equal(t, []sdk.Tx{replacement, tx1, tx2}, rechecked)
+}
+
+func TestRecheckMempool_ReplacementChecksOnlySigner(t *testing.T) {
+	ctx := newRecheckTestContext()
+	tracker := reserver.NewReservationTracker()
+	handle := tracker.NewHandle(1, reserver.WithRefCounter())
+	bc := newTestBlockchain(t, ctx)
+
+	var checked []sdk.Tx
+	rc := newMockRechecker(ctx, func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		checked = append(checked, tx)
+		return ctx, nil
+	})
+
+	mp := mempool.NewRecheckMempool(
+		nil, 0, handle, rc,
+		newTestRecheckedTxs(), newTestReapList(), bc, log.NewNopLogger(),
+		mempool.WithPriceBump(10),
+	)
+	mp.Start(testHeader(0))
+	t.Cleanup(func() { require.NoError(t, mp.Close()) })
+
+	acc := newRecheckTestAccount(t)
+	other := newRecheckTestAccount(t)
+	tx0 := newRecheckTestTxWithGasPrice(t, acc.key, 0, 100)
+	tx1 := newRecheckTestTxWithGasPrice(t, acc.key, 1, 100)
+	tx2 := newRecheckTestTxWithGasPrice(t, acc.key, 2, 100)
+	for _, tx := range []sdk.Tx{
+		tx0, tx1, tx2,
+		newRecheckTestTxWithGasPrice(t, other.key, 0, 100),
+		newRecheckTestTxWithGasPrice(t, other.key, 1, 100),
+	} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	// only the txs of the signer below the replaced sequence are rechecked,
+	// on a branch set up by the rechecker
+	checked = nil
+	replacement := newRecheckTestTxWithGasPrice(t, acc.key, 1, 110)
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, []sdk.Tx{tx0, replacement}, checked)
+	require.Equal(t, 1, rc.branches)
+	require.Equal(t, 5, mp.CountTx())
+}
+
+func TestRecheckMempool_AccountSlots(t *testing.T) {
+	ctx := newRecheckTestContext()
+	tracker := reserver.NewReservationTracker()
+	handle := tracker.NewHandle(1, reserver.WithRefCounter())
+	bc := newTestBlockchain(t, ctx)
+	rc := newMockRechecker(ctx, noopAnteHandler)
+
+	mp := mempool.NewRecheckMempool(
+		nil, 0, handle, rc,
+		newTestRecheckedTxs(), newTestReapList(), bc, log.NewNopLogger(),
+		mempool.WithAccountSlots(2),
+	)
+
+	acc := newRecheckTestAccount(t)
+	require.NoError(t, mp.Insert(ctx, newRecheckTestTxWithGasPrice(t, acc.key, 0, 1)))
+	require.NoError(t, mp.Insert(ctx, newRecheckTestTxWithGasPrice(t, acc.key, 1, 1)))
+
+	err := mp.Insert(ctx, newRecheckTestTxWithGasPrice(t, acc.key, 2, 1))
+	require.ErrorIs(t, err, mempool.ErrCosmosAccountSlotsFull)
+
+	// replacements don't take a slot, and the other accounts are not capped
+	require.NoError(t, mp.Insert(ctx, newRecheckTestTxWithGasPrice(t, acc.key, 1, 2)))
+	require.NoError(t, mp.Insert(ctx, newRecheckTestTx(t, mustGenKey(t))))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestRecheckMempool_EvictsLowestFee(t *testing.T) {
+	ctx := newRecheckTestContext()
+	tracker := reserver.NewReservationTracker()
+	handle := tracker.NewHandle(1, reserver.WithRefCounter())
+	bc := newTestBlockchain(t, ctx)
+	rc := newMockRechecker(ctx, noopAnteHandler)
+	reapList := newTestReapList()
+	recheckedTxs := newTestRecheckedTxs()
+
+	mp := mempool.NewRecheckMempool(
+		nil, 3, handle, rc,
+		recheckedTxs, reapList, bc, log.NewNopLogger(),
+	)
+
+	accA := newRecheckTestAccount(t)
+	accB := newRecheckTestAccount(t)
+	a0 := newRecheckTestTxWithGasPrice(t, accA.key, 0, 5)
+	a1 := newRecheckTestTxWithGasPrice(t, accA.key, 1, 1)
+	b0 := newRecheckTestTxWithGasPrice(t, accB.key, 0, 2)
+	for _, tx := range []sdk.Tx{a0, a1, b0} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	// a tx must pay more than the lowest pooled fee to evict it
+	err := mp.Insert(ctx, newRecheckTestTxWithGasPrice(t, mustGenKey(t), 0, 1))
+	require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity)
+
+	// a1 pays the lowest fee, and is the last tx of A
+	c0 := newRecheckTestTxWithGasPrice(t, mustGenKey(t), 0, 4)
+	require.NoError(t, mp.Insert(ctx, c0))
+	require.ElementsMatch(t, []sdk.Tx{a0, b0, c0}, collectIteratorTxs(mp.Select(context.Background(), nil)))
+	require.Len(t, reapList.Reap(0, 0), 3)
+
+	// a0 pays more than d0, so b0 is evicted and its signer released
+	d0 := newRecheckTestTxWithGasPrice(t, mustGenKey(t), 0, 3)
+	require.NoError(t, mp.Insert(ctx, d0))
+	require.ElementsMatch(t, []sdk.Tx{a0, c0, d0}, collectIteratorTxs(mp.Select(context.Background(), nil)))
+
+	otherHandle := tracker.NewHandle(2)
+	require.True(t, otherHandle.Has(accA.address))
+	require.False(t, otherHandle.Has(accB.address))
+
+	// the evicted txs are dropped from the snapshot
+	rechecked := collectIteratorTxs(mp.RecheckedTxs(context.Background(), big.NewInt(0)))
+	require.NotContains(t, rechecked, a1)
+	require.NotContains(t, rechecked, b0)
+}
+
+// newStoreNonceAnteHandler returns an ante handler that enforces sequential
+// nonces per account. Unlike newNonceTrackingAnteHandler, the nonces are kept
+// in the ctx store, so they follow the ctx the txs are checked against.
+func newStoreNonceAnteHandler(key storetypes.StoreKey) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		sigTx, ok := tx.(authsigning.SigVerifiableTx)
+		if !ok {
+			return ctx, nil
+		}
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			return sdk.Context{}, err
+		}
+		store := ctx.KVStore(key)
+		for _, sig := range sigs {
+			addr := sig.PubKey.Address()
+			var expected uint64
+			if bz := store.Get(addr); bz != nil {
+				expected = sdk.BigEndianToUint64(bz)
+			}
+			if sig.Sequence != expected {
+				return sdk.Context{}, fmt.Errorf("account %s: expected nonce %d, got %d", addr, expected, sig.Sequence)
+			}
+			store.Set(addr, sdk.Uint64ToBigEndian(expected+1))
+		}
+		return ctx, nil
+	}
+}
+
 // newRecheckTestTx creates a minimal sdk.Tx for unit testing RecheckMempool.
 func newRecheckTestTx(t *testing.T, key *ecdsa.PrivateKey) sdk.Tx {
 	t.Helper()
@@ -83,6 +83,12 @@ func (r *TxRechecker) RecheckCosmos(ctx sdk.Context, tx sdk.Tx) (sdk.Context, er
 //
 // NOTE: This function is not thread safe with itself or any other Rechecker functions.
 func (r *TxRechecker) Update(ctx sdk.Context, header *ethtypes.Header) {
+	r.ctx = r.Branch(ctx, header)
+}
+
+// Branch returns a branch of ctx set up for rechecks at header, the way Update
+// sets up the base context, without changing the base context.
+func (r *TxRechecker) Branch(ctx sdk.Context, header *ethtypes.Header) sdk.Context {
 	cached, _ := ctx.CacheContext()
 	cached = cached.WithBlockGasMeter(storetypes.NewGasMeter(header.GasLimit))
 	cached = cached.WithGasMeter(storetypes.NewInfiniteGasMeter())
@@ -99,5 +105,5 @@ func (r *TxRechecker) Update(ctx sdk.Context, header *ethtypes.Header) {
 		cp := cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: maxGas}}
 		cached = cached.WithConsensusParams(cp)
 	}
-	r.ctx = cached
+	return cached
 }
@@ -108,6 +108,24 @@ const (
 	RemovalReasonPrepareProposalInvalid txpool.RemovalReason = "prepare_proposal_invalid"
 )
 
+// DropReason describes why a tx was dropped from the pool without being
+// included in a block.
+type DropReason string
+
+const (
+	DropReasonUnderpriced DropReason = "underpriced"      // Tx was evicted by better priced txs or fell below the min tip
+	DropReasonReplaced    DropReason = "replaced"         // Tx was replaced by a tx with the same nonce and a higher price
+	DropReasonNonceTooLow DropReason = "nonce too low"    // Another tx with the same nonce was included in a block
+	DropReasonLifetime    DropReason = "lifetime expired" // Tx has been in queued for too long
+)
+
+// DroppedTxEvent is posted when a tx is dropped from the pool.
+type DroppedTxEvent struct {
+	Tx          *types.Transaction
+	Reason      DropReason
+	Replacement *types.Transaction // Tx that replaced the dropped one, only set for DropReasonReplaced
+}
+
 var (
 	// Specific removal metrics
 	// Queue pool
@@ -444,6 +462,7 @@ type LegacyPool struct {
 	chain       BlockChain
 	gasTip      atomic.Pointer[uint256.Int]
 	txFeed      event.Feed
+	dropFeed    event.Feed
 	signer      types.Signer
 	mu          sync.RWMutex
 
@@ -451,6 +470,7 @@ type LegacyPool struct {
 	currentState        vm.StateDB                         // Current state in the blockchain head
 	pendingNonces       *noncer                            // Pending state tracking virtual nonces
 	latestIncludedNonce *lru.Cache[common.Address, uint64] // Cache of latest nonce seen executed on chain for accounts
+	includedTxs         *lru.Cache[common.Hash, struct{}]  // Cache of txs seen executed on chain, not reported as dropped
 	reserver            reserver.Reserver                  // Address reserver to ensure exclusivity across subpools
 	rechecker           Rechecker                          // Checks a tx for validity against the current state
 
@@ -515,6 +535,7 @@ func New(
 
 	// only errors if size <= 0 and we have already validated this
 	nonceCache, _ := lru.New[common.Address, uint64](config.IncludedNonceCacheSize)
+	includedCache, _ := lru.New[common.Hash, struct{}](config.IncludedNonceCacheSize)
 
 	// Create the transaction pool with its initial settings
 	pool := &LegacyPool{
@@ -539,6 +560,7 @@ func New(
 		reorgShutdownCh:     make(chan struct{}),
 		initDoneCh:          make(chan struct{}),
 		latestIncludedNonce: nonceCache,
+		includedTxs:         includedCache,
 	}
 	pool.priced = newPricedList(pool.all)
 
@@ -557,6 +579,12 @@ func (pool *LegacyPool) SetLatestNonce(sender common.Address, nonce uint64) {
 	}
 }
 
+// MarkIncluded records that the tx has been executed on chain, so that it is
+// not reported as dropped once removed from the pool for its nonce.
+func (pool *LegacyPool) MarkIncluded(hash common.Hash) {
+	pool.includedTxs.Add(hash, struct{}{})
+}
+
 // LatestNonce returns the most recently recorded latest-included nonce for
 // addr and whether an entry exists in the cache. Primarily useful for tests
 // and debugging.
@@ -574,8 +602,17 @@ func (pool *LegacyPool) removeOlds(addr common.Address, l *list, poolType PoolTy
 
 	dropped := l.Forward(latest + 1)
 	for _, tx := range dropped {
-		pool.all.Remove(tx.Hash())
+		hash := tx.Hash()
+		pool.all.Remove(hash)
 		pool.markTxRemoved(addr, tx, poolType)
+
+		// txs included on chain were not dropped, only the ones whose nonce
+		// was used by another tx
+		if _, included := pool.includedTxs.Peek(hash); included {
+			pool.includedTxs.Remove(hash)
+		} else {
+			pool.markTxDropped(tx, DropReasonNonceTooLow, nil)
+		}
 	}
 
 	return dropped
@@ -718,6 +755,12 @@ func (pool *LegacyPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs
 	return pool.txFeed.Subscribe(ch)
 }
 
+// SubscribeDroppedTxs registers a subscription for txs dropped from the pool
+// before being included in a block.
+func (pool *LegacyPool) SubscribeDroppedTxs(ch chan<- DroppedTxEvent) event.Subscription {
+	return pool.dropFeed.Subscribe(ch)
+}
+
 // SetGasTip updates the minimum gas tip required by the transaction pool for a
 // new transaction, and drops all transactions below this threshold.
 func (pool *LegacyPool) SetGasTip(tip *big.Int) {
@@ -1100,6 +1143,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
 			pool.markTxRemoved(from, old, Pending)
+			pool.markTxDropped(old, DropReasonReplaced, tx)
 			pendingReplaceMeter.Add(context.Background(), 1)
 		}
 		pool.all.Add(tx)
@@ -1170,6 +1214,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 		pool.priced.Removed(1)
 		queuedReplaceMeter.Add(context.Background(), 1)
 		pool.markTxRemoved(from, old, Queue)
+		pool.markTxDropped(old, DropReasonReplaced, tx)
 	} else {
 		// Nothing was replaced, bump the queued counter
 		queuedGauge.Add(context.Background(), 1)
@@ -1208,6 +1253,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(hash)
 		pool.priced.Removed(1)
 		pool.markTxRemoved(addr, tx, Queue)
+		pool.markTxDropped(tx, DropReasonUnderpriced, nil)
 		pendingDiscardMeter.Add(context.Background(), 1)
 		return false
 	}
@@ -1218,6 +1264,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		pool.markTxRemoved(addr, old, Pending)
+		pool.markTxDropped(old, DropReasonReplaced, tx)
 		pendingReplaceMeter.Add(context.Background(), 1)
 	} else {
 		// Nothing was replaced, bump the pending counter
@@ -1453,6 +1500,9 @@ func (pool *LegacyPool) removeTx(hash common.Hash, outofbound bool, unreserve bo
 	if outofbound {
 		pool.priced.Removed(1)
 	}
+	if dropReason, ok := removalDropReason(reason); ok {
+		pool.markTxDropped(tx, dropReason, nil)
+	}
 	// Remove the transaction from the pending lists and reset the account nonce
 	if pending := pool.pending[addr]; pending != nil {
 		if removed, invalids := pending.Remove(tx); removed {
@@ -2509,6 +2559,12 @@ func (pool *LegacyPool) markTxRemoved(addr common.Address, tx *types.Transaction
 	}
 }
 
+// markTxDropped notifies the subscribers that the tx was dropped from the pool
+// for the given reason.
+func (pool *LegacyPool) markTxDropped(tx *types.Transaction, reason DropReason, replacement *types.Transaction) {
+	pool.dropFeed.Send(DroppedTxEvent{Tx: tx, Reason: reason, Replacement: replacement})
+}
+
 // markTxEnqueued records the queued-entry timestamp on the tracker.
 func (pool *LegacyPool) markTxEnqueued(tx *types.Transaction) {
 	_ = pool.tracker.EnteredQueued(tx.Hash())
@@ -2526,6 +2582,19 @@ func tolerateNonceGapErr(err error) error {
 	return err
 }
 
+// removalDropReason returns the reason reported to the dropped txs subscribers
+// for a removal, if the removal drops the tx.
+func removalDropReason(reason txpool.RemovalReason) (DropReason, bool) {
+	switch reason {
+	case RemovalReasonLifetime:
+		return DropReasonLifetime, true
+	case RemovalReasonBelowTip, RemovalReasonUnderpricedFull:
+		return DropReasonUnderpriced, true
+	default:
+		return "", false
+	}
+}
+
 func pendingRemovalMetric(reason txpool.RemovalReason) metric.Int64Counter {
 	switch reason {
 	case RemovalReasonLifetime:
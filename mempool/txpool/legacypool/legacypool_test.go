@@ -893,6 +893,57 @@ func TestScheduleForRemoval(t *testing.T) {
 	require.Empty(t, queued, "queued tx@10 drained by SetLatestNonce")
 }
 
+// Tests that the txs dropped from the pool are reported with the reason they
+// were dropped, and that the txs included on chain are not.
+func TestDroppedTxEvents(t *testing.T) {
+	t.Parallel()
+
+	pool, _, key := setupPool()
+	defer pool.Close()
+
+	dropped := make(chan DroppedTxEvent, 16)
+	sub := pool.SubscribeDroppedTxs(dropped)
+	defer sub.Unsubscribe()
+
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, addr, big.NewInt(1_000_000_000_000))
+
+	// a tx replaced by a better priced one
+	original := pricedTransaction(0, 100000, big.NewInt(1), key)
+	replacement := pricedTransaction(0, 100000, big.NewInt(2), key)
+	require.NoError(t, pool.addRemoteSync(original))
+	require.NoError(t, pool.addRemoteSync(replacement))
+
+	ev := <-dropped
+	require.Equal(t, original.Hash(), ev.Tx.Hash())
+	require.Equal(t, DropReasonReplaced, ev.Reason)
+	require.Equal(t, replacement.Hash(), ev.Replacement.Hash())
+
+	// the included tx is removed silently, the one whose nonce was used by
+	// another tx on chain is dropped
+	stale := pricedTransaction(1, 100000, big.NewInt(2), key)
+	require.NoError(t, pool.addRemoteSync(stale))
+	pool.MarkIncluded(replacement.Hash())
+	pool.SetLatestNonce(addr, stale.Nonce())
+	<-pool.requestReset(nil, nil)
+
+	ev = <-dropped
+	require.Equal(t, stale.Hash(), ev.Tx.Hash())
+	require.Equal(t, DropReasonNonceTooLow, ev.Reason)
+	require.Nil(t, ev.Replacement)
+
+	// a tx below the raised min tip
+	cheap := pricedTransaction(2, 100000, big.NewInt(2), key)
+	require.NoError(t, pool.addRemoteSync(cheap))
+	pool.SetGasTip(big.NewInt(3))
+
+	ev = <-dropped
+	require.Equal(t, cheap.Hash(), ev.Tx.Hash())
+	require.Equal(t, DropReasonUnderpriced, ev.Reason)
+
+	require.Empty(t, dropped)
+}
+
 // Tests that if an account runs out of funds, any pending and queued transactions
 // are dropped.
 func TestDropping(t *testing.T) {
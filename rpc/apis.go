@@ -6,12 +6,15 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/admin"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/ots"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/txpool"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/web3"
 	"github.com/cosmos/evm/rpc/stream"
@@ -35,6 +38,9 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	OtsNamespace      = "ots"
+	AdminNamespace    = "admin"
 
 	apiVersion = "1.0"
 )
@@ -68,7 +74,7 @@ func init() {
 				{
 					Namespace: EthNamespace,
 					Version:   apiVersion,
-					Service:   eth.NewPublicAPI(ctx.Logger, backend),
+					Service:   eth.NewPublicAPI(ctx.Logger, backend, stream),
 					Public:    true,
 				},
 				{
@@ -164,6 +170,58 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(
+			ctx *server.Context,
+			_ client.Context,
+			_ *stream.RPCStream,
+			backend backend.BackendI,
+		) []rpc.API {
+			// should not happen, but just in case
+			traceBackend, ok := backend.(trace.Backend)
+			if !ok {
+				panic("backend does not implement trace.Backend")
+			}
+
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx.Logger, traceBackend),
+					Public:    true,
+				},
+			}
+		},
+		OtsNamespace: func(
+			ctx *server.Context,
+			_ client.Context,
+			_ *stream.RPCStream,
+			backend backend.BackendI,
+		) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx.Logger, backend),
+					Public:    true,
+				},
+			}
+		},
+		AdminNamespace: func(
+			ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			backend backend.BackendI,
+		) []rpc.API {
+			cfg := backend.GetConfig()
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewAPI(ctx, clientCtx, cfg.EVM.EVMChainID, cfg.JSONRPC.AllowAddPeer),
+					Public:    false,
+				},
+			}
+		},
 	}
 }
 
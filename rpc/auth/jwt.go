@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+const (
+	// jwtExpiryTimeout is the max drift allowed between the issued-at claim of
+	// a token and the local time, as defined by the engine API authentication.
+	jwtExpiryTimeout = 60 * time.Second
+)
+
+// JWTAuth authenticates the calls to the protected JSON-RPC namespaces with
+// HS256 JSON web tokens, as done by the engine API of the execution clients.
+// The token is passed in the Authorization header of the http requests and
+// of the websocket handshake. A nil JWTAuth allows all the calls.
+type JWTAuth struct {
+	secret     []byte
+	namespaces map[string]bool
+	internal   *rpctypes.InternalMarker
+}
+
+// NewJWTAuth creates a JWTAuth protecting the namespaces with the hex encoded
+// 32 bytes secret read from the file. It returns nil if no secret file or
+// namespace is set. The requests marked by internal as forwarded for an
+// authenticated websocket connection are allowed.
+func NewJWTAuth(secretFile string, namespaces []string, internal *rpctypes.InternalMarker) (*JWTAuth, error) {
+	if secretFile == "" || len(namespaces) == 0 {
+		return nil, nil
+	}
+
+	secret, err := readSecret(secretFile)
+	if err != nil {
+		return nil, err
+	}
+
+	protected := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		protected[namespace] = true
+	}
+	return &JWTAuth{
+		secret:     secret,
+		namespaces: protected,
+		internal:   internal,
+	}, nil
+}
+
+// NewHTTPAuth returns the authentication of the calls to the JSON-RPC servers
+// sharing the hex encoded 32 bytes secret read from the file, which sets a
+// fresh HS256 bearer token on each request. It returns nil if no secret file
+// is set.
+func NewHTTPAuth(secretFile string) (ethrpc.HTTPAuth, error) {
+	if secretFile == "" {
+		return nil, nil
+	}
+
+	secret, err := readSecret(secretFile)
+	if err != nil {
+		return nil, err
+	}
+	return func(h http.Header) error {
+		h.Set("Authorization", "Bearer "+newToken(secret, time.Now()))
+		return nil
+	}, nil
+}
+
+// readSecret reads the hex encoded 32 bytes secret from the file.
+func readSecret(secretFile string) ([]byte, error) {
+	bz, err := os.ReadFile(secretFile) //#nosec G304 -- path is set by the node operator
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT secret: %w", err)
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT secret: %w", err)
+	}
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("invalid JWT secret length, expected 32 bytes, got %d", len(secret))
+	}
+	return secret, nil
+}
+
+// newToken returns a HS256 token issued at now, signed with the secret.
+func newToken(secret []byte, now time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"iat":` + strconv.FormatInt(now.Unix(), 10) + `}`))
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(header + "." + claims))
+	return header + "." + claims + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Authenticate returns true if the request holds a valid bearer token.
+func (a *JWTAuth) Authenticate(r *http.Request) bool {
+	if a == nil {
+		return true
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	return a.verify(token, time.Now()) == nil
+}
+
+// verify checks the signature and the issued-at claim of the token.
+func (a *JWTAuth) verify(token string, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return err
+	}
+	if header.Alg != "HS256" {
+		return fmt.Errorf("unexpected signing method %s", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errors.New("invalid signature")
+	}
+
+	var claims struct {
+		IssuedAt  *int64 `json:"iat"`
+		ExpiresAt *int64 `json:"exp"`
+	}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return err
+	}
+	switch {
+	case claims.ExpiresAt != nil && now.After(time.Unix(*claims.ExpiresAt, 0)):
+		return errors.New("token is expired")
+	case claims.IssuedAt == nil:
+		return errors.New("missing issued-at")
+	case now.Sub(time.Unix(*claims.IssuedAt, 0)) > jwtExpiryTimeout:
+		return errors.New("stale token")
+	case time.Unix(*claims.IssuedAt, 0).Sub(now) > jwtExpiryTimeout:
+		return errors.New("future token")
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	bz, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// Check returns nil if the calls of the JSON-RPC request or batch are allowed,
+// otherwise the JSON-RPC error response to send back. Requests which can't be
+// decoded are allowed so that the server reports the error.
+func (a *JWTAuth) Check(authenticated bool, body []byte) []byte {
+	if a == nil || authenticated {
+		return nil
+	}
+
+	calls, batch, err := rpctypes.DecodeJSONRPCCalls(body)
+	if err != nil {
+		return nil
+	}
+
+	allowed := true
+	for _, call := range calls {
+		if a.protected(call.Method) {
+			allowed = false
+			break
+		}
+	}
+	if allowed {
+		return nil
+	}
+
+	return rpctypes.EncodeJSONRPCErrors(calls, batch, rpctypes.ErrCodeUnauthorized, func(call rpctypes.JSONRPCCall) string {
+		if a.protected(call.Method) {
+			return "missing or invalid JWT for " + call.Method
+		}
+		return "batch holds calls to protected namespaces"
+	})
+}
+
+// protected returns true if the namespace of the method is protected.
+func (a *JWTAuth) protected(method string) bool {
+	namespace, _, _ := strings.Cut(method, "_")
+	return a.namespaces[namespace]
+}
+
+// Handler wraps the http handler of the JSON-RPC server to reject the
+// unauthenticated calls to the protected namespaces. Requests larger than
+// bodyLimit are rejected, since the JSON-RPC server would serve the truncated
+// body of a chunked request.
+func (a *JWTAuth) Handler(next http.Handler, bodyLimit int) http.Handler {
+	if a == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the calls forwarded for an authenticated websocket connection
+		if _, authenticated := a.internal.Internal(r); authenticated {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := rpctypes.PeekRequestBody(r, bodyLimit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(body) > bodyLimit {
+			http.Error(w, fmt.Sprintf("content length too large (%d>%d)", len(body), bodyLimit), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if res := a.Check(a.Authenticate(r), body); res != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write(res)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
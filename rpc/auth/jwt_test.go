@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestJWTAuth(t *testing.T) *JWTAuth {
+	t.Helper()
+	a, _ := newTestJWTAuthWithMarker(t)
+	return a
+}
+
+func newTestJWTAuthWithMarker(t *testing.T) (*JWTAuth, *rpctypes.InternalMarker) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(path, []byte("0x"+hex.EncodeToString(testSecret)+"\n"), 0o600))
+	internal := rpctypes.NewInternalMarker()
+	a, err := NewJWTAuth(path, []string{"debug", "personal"}, internal)
+	require.NoError(t, err)
+	return a, internal
+}
+
+// signToken returns a HS256 token with the claims signed with the secret.
+func signToken(secret []byte, alg string, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// errorResponse is a JSON-RPC error response returned by JWTAuth.
+type errorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func request(method string) []byte {
+	bz, _ := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": method})
+	return bz
+}
+
+func TestNewJWTAuth(t *testing.T) {
+	a, err := NewJWTAuth("", []string{"debug"}, nil)
+	require.NoError(t, err)
+	require.Nil(t, a)
+	require.True(t, a.Authenticate(httptest.NewRequest(http.MethodPost, "/", nil)))
+	require.Nil(t, a.Check(false, request("debug_traceTransaction")))
+
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(path, []byte("abcd"), 0o600))
+	_, err = NewJWTAuth(path, []string{"debug"}, nil)
+	require.ErrorContains(t, err, "invalid JWT secret length")
+
+	_, err = NewJWTAuth(filepath.Join(t.TempDir(), "missing"), []string{"debug"}, nil)
+	require.ErrorContains(t, err, "failed to read JWT secret")
+}
+
+func TestNewHTTPAuth(t *testing.T) {
+	httpAuth, err := NewHTTPAuth("")
+	require.NoError(t, err)
+	require.Nil(t, httpAuth)
+
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(testSecret)), 0o600))
+	httpAuth, err = NewHTTPAuth(path)
+	require.NoError(t, err)
+
+	// the tokens are accepted by the servers sharing the secret
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, httpAuth(req.Header))
+	require.True(t, newTestJWTAuth(t).Authenticate(req))
+}
+
+func TestVerify(t *testing.T) {
+	a := newTestJWTAuth(t)
+	now := time.Now()
+
+	testCases := []struct {
+		name   string
+		token  string
+		expErr string
+	}{
+		{"valid", signToken(testSecret, "HS256", map[string]interface{}{"iat": now.Unix()}), ""},
+		{"drift within bounds", signToken(testSecret, "HS256", map[string]interface{}{"iat": now.Add(-50 * time.Second).Unix()}), ""},
+		{"wrong secret", signToken([]byte("wrong"), "HS256", map[string]interface{}{"iat": now.Unix()}), "invalid signature"},
+		{"other signing method", signToken(testSecret, "none", map[string]interface{}{"iat": now.Unix()}), "unexpected signing method"},
+		{"missing issued-at", signToken(testSecret, "HS256", map[string]interface{}{}), "missing issued-at"},
+		{"stale", signToken(testSecret, "HS256", map[string]interface{}{"iat": now.Add(-2 * time.Minute).Unix()}), "stale token"},
+		{"future", signToken(testSecret, "HS256", map[string]interface{}{"iat": now.Add(2 * time.Minute).Unix()}), "future token"},
+		{"expired", signToken(testSecret, "HS256", map[string]interface{}{"iat": now.Unix(), "exp": now.Add(-time.Second).Unix()}), "token is expired"},
+		{"malformed", "abc", "malformed token"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := a.verify(tc.token, now)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	a := newTestJWTAuth(t)
+
+	require.Nil(t, a.Check(false, request("eth_blockNumber")))
+	require.Nil(t, a.Check(true, request("debug_traceTransaction")))
+
+	res := a.Check(false, request("debug_traceTransaction"))
+	var errRes errorResponse
+	require.NoError(t, json.Unmarshal(res, &errRes))
+	require.Equal(t, rpctypes.ErrCodeUnauthorized, errRes.Error.Code)
+
+	// a batch is rejected as a whole
+	batch := []byte("[" + string(request("eth_blockNumber")) + "," + string(request("personal_listAccounts")) + "]")
+	res = a.Check(false, batch)
+	var errResps []errorResponse
+	require.NoError(t, json.Unmarshal(res, &errResps))
+	require.Len(t, errResps, 2)
+	for _, errRes := range errResps {
+		require.Equal(t, rpctypes.ErrCodeUnauthorized, errRes.Error.Code)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	a, internal := newTestJWTAuthWithMarker(t)
+	handler := a.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), 1024)
+
+	send := func(body []byte, token string, mark func(*http.Request)) int {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if mark != nil {
+			mark(req)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	token := signToken(testSecret, "HS256", map[string]interface{}{"iat": time.Now().Unix()})
+	require.Equal(t, http.StatusOK, send(request("eth_blockNumber"), "", nil))
+	require.Equal(t, http.StatusUnauthorized, send(request("debug_traceTransaction"), "", nil))
+	require.Equal(t, http.StatusUnauthorized, send(request("debug_traceTransaction"), "invalid", nil))
+	require.Equal(t, http.StatusOK, send(request("debug_traceTransaction"), token, nil))
+
+	// the calls forwarded by the websocket server are allowed only for an
+	// authenticated connection
+	authenticated := func(r *http.Request) { internal.Mark(r, true) }
+	unauthenticated := func(r *http.Request) { internal.Mark(r, false) }
+	require.Equal(t, http.StatusOK, send(request("debug_traceTransaction"), "", authenticated))
+	require.Equal(t, http.StatusUnauthorized, send(request("debug_traceTransaction"), "", unauthenticated))
+	require.Equal(t, http.StatusUnauthorized, send(request("debug_traceTransaction"), "", func(r *http.Request) {
+		rpctypes.NewInternalMarker().Mark(r, true)
+	}))
+}
+
+func TestHandlerOversizedBody(t *testing.T) {
+	a := newTestJWTAuth(t)
+	served := false
+	ts := httptest.NewServer(a.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		served = true
+		w.WriteHeader(http.StatusOK)
+	}), 1024))
+	defer ts.Close()
+
+	// a chunked body holding a protected call in its first bytes, padded past
+	// the body limit, is rejected instead of being served truncated
+	body := io.MultiReader(bytes.NewReader(request("debug_traceTransaction")), bytes.NewReader(make([]byte, 2048)))
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, ts.URL, body)
+	require.NoError(t, err)
+	req.TransferEncoding = []string{"chunked"}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusRequestEntityTooLarge, res.StatusCode)
+	require.False(t, served)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -23,6 +25,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -159,6 +162,225 @@ func (b *Backend) GetStorageAt(ctx context.Context, address common.Address, key
 	return value.Bytes(), nil
 }
 
+// GetAccount returns the nonce, balance, code hash and storage root of the
+// account at the given block.
+func (b *Backend) GetAccount(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (result *rpctypes.AccountInfo, err error) {
+	ctx, span := tracer.Start(ctx, "GetAccount", trace.WithAttributes(attribute.String("address", address.String()), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	accounts, err := b.GetAccounts(ctx, []common.Address{address}, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return accounts[0], nil
+}
+
+// GetAccounts returns the nonce, balance, code hash and storage root of the
+// accounts at the given block, in the order of the addresses. The accounts
+// are read in a single query so that they reflect the same state.
+func (b *Backend) GetAccounts(ctx context.Context, addresses []common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (result []*rpctypes.AccountInfo, err error) {
+	ctx, span := tracer.Start(ctx, "GetAccounts", trace.WithAttributes(attribute.Int("addresses", len(addresses)), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if len(addresses) == 0 {
+		return []*rpctypes.AccountInfo{}, nil
+	}
+	if len(addresses) > evmtypes.MaxAccountsQueryAddresses {
+		return nil, fmt.Errorf("too many addresses, got %d, max %d", len(addresses), evmtypes.MaxAccountsQueryAddresses)
+	}
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryAccountsRequest{
+		Addresses: make([]string, len(addresses)),
+	}
+	for i, address := range addresses {
+		req.Addresses[i] = address.Hex()
+	}
+
+	ctx = rpctypes.ContextWithHeight(ctx, blockNum.Int64())
+	res, err := b.QueryClient.Accounts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Accounts) != len(addresses) {
+		return nil, fmt.Errorf("invalid accounts response, got %d accounts, expected %d", len(res.Accounts), len(addresses))
+	}
+
+	result = make([]*rpctypes.AccountInfo, len(res.Accounts))
+	for i, acct := range res.Accounts {
+		balance, ok := sdkmath.NewIntFromString(acct.Balance)
+		if !ok {
+			return nil, errors.New("invalid balance")
+		}
+		// balance can only be negative in case of pruned node
+		if balance.IsNegative() {
+			return nil, errors.New("couldn't fetch balance. Node state is pruned")
+		}
+
+		result[i] = &rpctypes.AccountInfo{
+			CodeHash:    common.HexToHash(acct.CodeHash),
+			StorageRoot: common.HexToHash(acct.StorageRoot),
+			Balance:     (*hexutil.Big)(balance.BigInt()),
+			Nonce:       hexutil.Uint64(acct.Nonce),
+		}
+	}
+
+	return result, nil
+}
+
+// accountRangeMaxResults is the maximum number of accounts returned by a single
+// AccountRange call, as in geth.
+const accountRangeMaxResults = 256
+
+// StorageRangeAt returns up to maxResult storage slots of the account at the
+// given block, starting from the keyStart storage key. The slots are ordered by
+// key and the returned next key is the key to start the next range from.
+func (b *Backend) StorageRangeAt(ctx context.Context, address common.Address, keyStart hexutil.Bytes, maxResult int, blockNrOrHash rpctypes.BlockNumberOrHash) (result rpctypes.StorageRangeResult, err error) {
+	ctx, span := tracer.Start(ctx, "StorageRangeAt", trace.WithAttributes(attribute.String("address", address.String()), attribute.Int("maxResult", maxResult), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if maxResult < 0 {
+		return rpctypes.StorageRangeResult{}, errors.New("maxResult cannot be negative")
+	}
+
+	ctx = rpctypes.ContextWithHeight(ctx, blockNum.Int64())
+	res, err := b.QueryClient.StorageRange(ctx, &evmtypes.QueryStorageRangeRequest{
+		Address: address.String(),
+		Pagination: &query.PageRequest{
+			Key:   keyStart,
+			Limit: uint64(maxResult),
+		},
+	})
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	// the storage is keyed by the hash of the slot keys, as in the geth tries
+	result.Storage = make(rpctypes.StorageMap, len(res.Storage))
+	for _, slot := range res.Storage {
+		key := common.HexToHash(slot.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(slot.Value),
+		}
+	}
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.Pagination.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
+// AccountRange returns up to maxResults accounts at the given block, starting
+// from the start address. The code and the storage of the accounts are
+// omitted if nocode and nostorage are set. The returned next address is the
+// address to start the next range from.
+func (b *Backend) AccountRange(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage bool) (result state.Dump, err error) {
+	ctx, span := tracer.Start(ctx, "AccountRange", trace.WithAttributes(attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash)), attribute.Int("maxResults", maxResults)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	header, err := b.HeaderByNumber(ctx, blockNum)
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	if maxResults <= 0 || maxResults > accountRangeMaxResults {
+		maxResults = accountRangeMaxResults
+	}
+
+	ctx = rpctypes.ContextWithHeight(ctx, header.Number.Int64())
+	res, err := b.QueryClient.Accounts(ctx, &evmtypes.QueryAccountsRequest{
+		Pagination: &query.PageRequest{
+			Key:   start,
+			Limit: uint64(maxResults),
+		},
+	})
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	result = state.Dump{
+		Root:     header.Root.Hex(),
+		Accounts: make(map[string]state.DumpAccount, len(res.Accounts)),
+	}
+	for _, acct := range res.Accounts {
+		address := common.HexToAddress(acct.Address)
+		codeHash := common.HexToHash(acct.CodeHash)
+		account := state.DumpAccount{
+			Balance:     acct.Balance,
+			Nonce:       acct.Nonce,
+			Root:        common.Hash{}.Bytes(), // NOTE: Cosmos EVM doesn't have a storage hash.
+			CodeHash:    codeHash.Bytes(),
+			Address:     &address,
+			AddressHash: crypto.Keccak256(address.Bytes()),
+		}
+
+		if !nocode && codeHash != common.BytesToHash(evmtypes.EmptyCodeHash) {
+			codeRes, err := b.QueryClient.Code(ctx, &evmtypes.QueryCodeRequest{Address: acct.Address})
+			if err != nil {
+				return state.Dump{}, err
+			}
+			account.Code = codeRes.Code
+		}
+
+		if !nostorage {
+			if account.Storage, err = b.accountStorage(ctx, acct.Address); err != nil {
+				return state.Dump{}, err
+			}
+		}
+
+		result.Accounts[address.String()] = account
+	}
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		result.Next = res.Pagination.NextKey
+	}
+
+	return result, nil
+}
+
+// accountStorage returns the whole storage of the account, the values are
+// formatted as in the geth state dumps.
+func (b *Backend) accountStorage(ctx context.Context, address string) (map[common.Hash]string, error) {
+	var (
+		storage map[common.Hash]string
+		nextKey []byte
+	)
+	for {
+		res, err := b.QueryClient.StorageRange(ctx, &evmtypes.QueryStorageRangeRequest{
+			Address:    address,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, slot := range res.Storage {
+			if storage == nil {
+				storage = make(map[common.Hash]string)
+			}
+			value := common.HexToHash(slot.Value)
+			storage[common.HexToHash(slot.Key)] = common.Bytes2Hex(common.TrimLeftZeroes(value.Bytes()))
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return storage, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
 // GetBalance returns the provided account's *spendable* balance up to the provided block number.
 func (b *Backend) GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (result *hexutil.Big, err error) {
 	ctx, span := tracer.Start(ctx, "GetBalance", trace.WithAttributes(attribute.String("address", address.String()), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash))))
@@ -170,15 +392,21 @@ func (b *Backend) GetBalance(ctx context.Context, address common.Address, blockN
 	}
 	ctx = rpctypes.ContextWithHeight(ctx, blockNum.Int64())
 
-	req := &evmtypes.QueryBalanceRequest{
-		Address: address.String(),
+	_, err = b.CometHeaderByNumber(ctx, blockNum)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = b.CometHeaderByNumber(ctx, blockNum)
+	pendingTxs, err := b.pendingMsgs(ctx, blockNum)
 	if err != nil {
 		return nil, err
 	}
 
+	req := &evmtypes.QueryBalanceRequest{
+		Address:    address.String(),
+		PendingTxs: pendingTxs,
+	}
+
 	res, err := b.QueryClient.Balance(ctx, req)
 	if err != nil {
 		return nil, err
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -19,6 +20,7 @@ import (
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
@@ -51,6 +53,8 @@ type EVMBackend interface {
 	SetEtherbase(ctx context.Context, etherbase common.Address) bool
 	SetGasPrice(ctx context.Context, gasPrice hexutil.Big) bool
 	ImportRawKey(privkey, password string) (common.Address, error)
+	ImportKeystore(keyJSON, password string) (common.Address, error)
+	ExportKeystore(address common.Address, password string) (json.RawMessage, error)
 	ListAccounts() ([]common.Address, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
@@ -58,10 +62,13 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
+	RPCTxSyncDefaultTimeout() time.Duration
+	RPCTxSyncMaxTimeout() time.Duration
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	SendTransaction(ctx context.Context, args evmtypes.TransactionArgs) (common.Hash, error)
+	SignTransaction(ctx context.Context, args evmtypes.TransactionArgs) (*types.SignTransactionResult, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
 
 	// Blocks Info
@@ -90,6 +97,10 @@ type EVMBackend interface {
 	GetBalance(ctx context.Context, address common.Address, blockNrOrHash types.BlockNumberOrHash) (*hexutil.Big, error)
 	GetStorageAt(ctx context.Context, address common.Address, key string, blockNrOrHash types.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash types.BlockNumberOrHash) (*types.AccountResult, error)
+	GetAccount(ctx context.Context, address common.Address, blockNrOrHash types.BlockNumberOrHash) (*types.AccountInfo, error)
+	GetAccounts(ctx context.Context, addresses []common.Address, blockNrOrHash types.BlockNumberOrHash) ([]*types.AccountInfo, error)
+	StorageRangeAt(ctx context.Context, address common.Address, keyStart hexutil.Bytes, maxResult int, blockNrOrHash types.BlockNumberOrHash) (types.StorageRangeResult, error)
+	AccountRange(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage bool) (state.Dump, error)
 	GetTransactionCount(ctx context.Context, address common.Address, blockNum types.BlockNumber) (*hexutil.Uint64, error)
 
 	// Chain Info
@@ -107,6 +118,9 @@ type EVMBackend interface {
 	GetTransactionByHash(ctx context.Context, txHash common.Hash) (*types.RPCTransaction, error)
 	GetTxByEthHash(ctx context.Context, txHash common.Hash) (*servertypes.TxResult, error)
 	GetTxByTxIndex(ctx context.Context, height int64, txIndex uint) (*servertypes.TxResult, error)
+	SearchTxsByAddress(ctx context.Context, address common.Address, height int64, reverse bool, limit int) ([]common.Hash, bool, error)
+	GetTxHashBySenderAndNonce(ctx context.Context, sender common.Address, nonce uint64) (*common.Hash, error)
+	GetContractCreationTxHash(ctx context.Context, contract common.Address) (*common.Hash, error)
 	GetTransactionByBlockAndIndex(ctx context.Context, block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*types.RPCTransaction, error)
 	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
 	GetTransactionLogs(ctx context.Context, hash common.Hash) ([]*ethtypes.Log, error)
@@ -120,11 +134,17 @@ type EVMBackend interface {
 	SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
 	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(ctx context.Context, opts types.SimOpts, blockNrOrHash *types.BlockNumberOrHash) ([]map[string]interface{}, error)
+	SendBundle(ctx context.Context, args types.SendBundleArgs) (common.Hash, error)
+	CallBundle(ctx context.Context, args types.CallBundleArgs) (*types.CallBundleResult, error)
+	SendPrivateTransaction(ctx context.Context, args types.PrivateTxArgs) (common.Hash, error)
+	CancelPrivateTransaction(ctx context.Context, args types.CancelPrivateTxArgs) (bool, error)
 	GasPrice(ctx context.Context) (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(ctx context.Context, hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	GetLogBlocks(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
@@ -149,6 +169,31 @@ type TrackingMempool interface {
 	TrackTx(hash common.Hash) error
 }
 
+// PendingMempool is a set of methods that a mempool may implement in order to
+// build the pending block.
+type PendingMempool interface {
+	// PendingEVMTxs returns the evm txs that would be included in the next
+	// block, in execution order, up to the gas limit.
+	PendingEVMTxs(ctx context.Context, gasLimit uint64) ethtypes.Transactions
+}
+
+// BundleMempool is a set of methods that a mempool may implement in order to
+// accept bundles of txs included atomically in a block.
+type BundleMempool interface {
+	// InsertBundle adds the bundle to the mempool and returns its hash.
+	InsertBundle(bundle *evmmempool.Bundle) (common.Hash, error)
+}
+
+// PrivateMempool is a set of methods that a mempool may implement in order to
+// hold txs which are not broadcast to the network.
+type PrivateMempool interface {
+	// InsertPrivateTx adds the tx to the mempool until the max block number.
+	InsertPrivateTx(tx *ethtypes.Transaction, maxBlockNumber uint64) error
+	// CancelPrivateTx drops the tx sent by from from the mempool, it returns
+	// false if the mempool holds no such tx.
+	CancelPrivateTx(hash common.Hash, from common.Address) bool
+}
+
 var (
 	_ BackendI = (*Backend)(nil)
 
@@ -198,6 +243,11 @@ type Backend struct {
 	Indexer             servertypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
 	Mempool             Mempool
+
+	cache          *responseCache
+	gasOracle      *gasPriceOracle
+	externalSigner *externalSigner
+	privateTxPeers []*privateTxPeer
 }
 
 // Opt is a function type that configures the backend.
@@ -208,6 +258,16 @@ func WithUnprotectedTxs(value bool) Opt {
 	return func(b *Backend) { b.AllowUnprotectedTxs = value }
 }
 
+// WithPrivateTxAuth sets the authentication of the calls forwarding the private
+// txs to the trusted peers.
+func WithPrivateTxAuth(auth rpc.HTTPAuth) Opt {
+	return func(b *Backend) {
+		for _, peer := range b.privateTxPeers {
+			peer.auth = auth
+		}
+	}
+}
+
 // WithLogger sets the logger for the backend.
 func WithLogger(logger log.Logger) Opt {
 	return func(b *Backend) { b.Logger = logger.With("module", "backend") }
@@ -241,9 +301,15 @@ func NewBackend(
 		Indexer:             indexer,
 		Mempool:             mempool,
 		Logger:              log.NewNopLogger(),
+		cache:               newResponseCache(appConf.JSONRPC.ResponseCacheSize),
+		gasOracle:           newGasPriceOracle(appConf.JSONRPC.GasPriceOracle),
+		privateTxPeers:      newPrivateTxPeers(appConf.JSONRPC.PrivateTxPeers),
 	}
 
 	b.ProcessBlocker = b.ProcessBlock
+	if appConf.JSONRPC.ExternalSigner != "" {
+		b.externalSigner = newExternalSigner(appConf.JSONRPC.ExternalSigner)
+	}
 
 	for _, opt := range opts {
 		opt(b)
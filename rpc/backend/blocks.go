@@ -57,6 +57,15 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 	ctx, span := tracer.Start(ctx, "GetBlockByNumber", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64()), attribute.Bool("fullTx", fullTx)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if blockNum == types.EthPendingBlockNumber {
+		pending, err := b.PendingBlock(ctx)
+		if err != nil {
+			b.Logger.Debug("failed to build the pending block", "error", err.Error())
+		} else if pending != nil {
+			return types.RPCMarshalPendingBlock(pending, fullTx, b.ChainConfig()), nil
+		}
+	}
+
 	resBlock, err := b.CometBlockByNumber(ctx, blockNum)
 	if err != nil {
 		return nil, nil
@@ -67,7 +76,7 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "height", blockNum, "error", err.Error())
 		return nil, nil
@@ -98,7 +107,7 @@ func (b *Backend) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx b
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "block-hash", hash.String(), "error", err.Error())
 		return nil, nil
@@ -156,7 +165,7 @@ func (b *Backend) GetBlockTransactionCountByNumber(ctx context.Context, blockNum
 func (b *Backend) getBlockTransactionCount(ctx context.Context, block *cmtrpctypes.ResultBlock) *hexutil.Uint {
 	ctx, span := tracer.Start(ctx, "getBlockTransactionCount")
 	defer span.End()
-	blockRes, err := b.RPCClient.BlockResults(ctx, &block.Block.Height)
+	blockRes, err := b.blockResults(ctx, &block.Block.Height)
 	if err != nil {
 		return nil
 	}
@@ -181,7 +190,7 @@ func (b *Backend) EthBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 		return nil, fmt.Errorf("block not found for height %d", blockNum)
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
@@ -216,7 +225,11 @@ func (b *Backend) GetBlockReceipts(
 		return nil, fmt.Errorf("block not found for height %d", *blockNum.CmtHeight())
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, blockNum.CmtHeight())
+	if receipts, ok := b.cache.getBlockReceipts(resBlock.Block.Height); ok {
+		return receipts, nil
+	}
+
+	blockRes, err := b.blockResults(ctx, blockNum.CmtHeight())
 	if err != nil {
 		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
@@ -247,5 +260,6 @@ func (b *Backend) GetBlockReceipts(
 			return nil, fmt.Errorf("failed to marshal receipt")
 		}
 	}
+	b.cache.addBlockReceipts(resBlock.Block.Height, result)
 	return result, nil
 }
@@ -0,0 +1,184 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// SendBundle adds a bundle of signed txs to the mempool, to be included
+// atomically in the target block. The txs are validated like the ones of
+// eth_sendRawTransaction and executed in order on top of the latest block,
+// and the bundle is rejected if any of them fails.
+func (b *Backend) SendBundle(ctx context.Context, args rpctypes.SendBundleArgs) (result common.Hash, err error) {
+	ctx, span := tracer.Start(ctx, "SendBundle", trace.WithAttributes(attribute.Int("txs", len(args.Txs))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	bm, ok := b.Mempool.(BundleMempool)
+	if !ok {
+		return common.Hash{}, errors.New("bundles are not supported by the mempool")
+	}
+
+	txs, _, err := b.decodeBundle(args.Txs)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	bundle := &evmmempool.Bundle{
+		Txs:         txs,
+		BlockNumber: uint64(args.BlockNumber),
+	}
+	if args.MinTimestamp != nil {
+		bundle.MinTimestamp = *args.MinTimestamp
+	}
+	if args.MaxTimestamp != nil {
+		bundle.MaxTimestamp = *args.MaxTimestamp
+	}
+	return bm.InsertBundle(bundle)
+}
+
+// CallBundle executes a bundle of signed txs in order in a block simulated on
+// top of the state block, and returns the result of each tx.
+func (b *Backend) CallBundle(ctx context.Context, args rpctypes.CallBundleArgs) (result *rpctypes.CallBundleResult, err error) {
+	ctx, span := tracer.Start(ctx, "CallBundle", trace.WithAttributes(attribute.Int("txs", len(args.Txs))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	txs, senders, err := b.decodeBundle(args.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	stateBlockNumber := rpctypes.EthLatestBlockNumber
+	if args.StateBlockNumber != nil {
+		stateBlockNumber = *args.StateBlockNumber
+	}
+	stateHeader, err := b.CometHeaderByNumber(ctx, stateBlockNumber)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+	stateBlockNumber = rpctypes.BlockNumber(stateHeader.Header.Height)
+
+	overrides := &rpctypes.BlockOverrides{}
+	if args.BlockNumber != 0 {
+		overrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(uint64(args.BlockNumber)))
+	}
+	if args.Timestamp != nil {
+		overrides.Time = (*hexutil.Uint64)(args.Timestamp)
+	}
+
+	block, err := b.simulateBundle(ctx, txs, senders, stateBlockNumber, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	result = &rpctypes.CallBundleResult{
+		BundleHash:       (&evmmempool.Bundle{Txs: txs}).Hash(),
+		Results:          make([]rpctypes.BundleTxResult, len(txs)),
+		StateBlockNumber: hexutil.Uint64(stateBlockNumber.Int64()), //nolint:gosec // G115 -- the height is positive
+	}
+	var (
+		weighted = new(big.Int)
+		baseFee  = block.Header.BaseFee
+	)
+	for i, tx := range txs {
+		call := block.Calls[i]
+		gasPrice := tx.GasPrice()
+		if baseFee != nil {
+			gasPrice = new(big.Int).Add(baseFee, tx.EffectiveGasTipValue(baseFee))
+		}
+		res := rpctypes.BundleTxResult{
+			TxHash:      tx.Hash(),
+			FromAddress: senders[i],
+			ToAddress:   tx.To(),
+			GasUsed:     call.GasUsed,
+			GasPrice:    (*hexutil.Big)(gasPrice),
+			Value:       call.ReturnValue,
+		}
+		if call.Error != nil {
+			res.Error = call.Error.Message
+			if data, err := hexutil.Decode(call.Error.Data); err == nil {
+				if reason, err := abi.UnpackRevert(data); err == nil {
+					res.Revert = reason
+				}
+			}
+		}
+		result.Results[i] = res
+		result.TotalGasUsed += call.GasUsed
+		weighted.Add(weighted, new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(uint64(call.GasUsed))))
+	}
+	if result.TotalGasUsed > 0 {
+		weighted.Quo(weighted, new(big.Int).SetUint64(uint64(result.TotalGasUsed)))
+	}
+	result.BundleGasPrice = (*hexutil.Big)(weighted)
+	return result, nil
+}
+
+// decodeBundle decodes and validates the signed txs of a bundle, and returns
+// them along with their senders.
+func (b *Backend) decodeBundle(rawTxs []hexutil.Bytes) ([]*ethtypes.Transaction, []common.Address, error) {
+	if len(rawTxs) == 0 {
+		return nil, nil, evmmempool.ErrBundleEmpty
+	}
+	if len(rawTxs) > evmmempool.MaxBundleTxs {
+		return nil, nil, fmt.Errorf("%w: %d > %d", evmmempool.ErrBundleTooLarge, len(rawTxs), evmmempool.MaxBundleTxs)
+	}
+
+	txs := make([]*ethtypes.Transaction, len(rawTxs))
+	senders := make([]common.Address, len(rawTxs))
+	for i, data := range rawTxs {
+		tx := &ethtypes.Transaction{}
+		if err := tx.UnmarshalBinary(data); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode bundle tx %d: %w", i, err)
+		}
+		ethereumTx, err := b.validateRawTransaction(tx)
+		if err != nil {
+			return nil, nil, err
+		}
+		txs[i], senders[i] = tx, ethereumTx.GetSender()
+	}
+	return txs, senders, nil
+}
+
+// simulateBundle executes the txs in order, with their nonces and fees
+// validated, in a single block simulated on top of the state block.
+func (b *Backend) simulateBundle(
+	ctx context.Context,
+	txs []*ethtypes.Transaction,
+	senders []common.Address,
+	stateBlockNumber rpctypes.BlockNumber,
+	overrides *rpctypes.BlockOverrides,
+) (*rpctypes.SimBlockResult, error) {
+	calls := make([]evmtypes.TransactionArgs, len(txs))
+	for i, tx := range txs {
+		calls[i] = rpctypes.BundleCallArgs(tx, senders[i])
+	}
+	opts := rpctypes.SimOpts{
+		BlockStateCalls: []rpctypes.SimBlock{{BlockOverrides: overrides, Calls: calls}},
+		Validation:      true,
+	}
+
+	blocks, err := b.simulate(ctx, opts, stateBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	// the gap up to an overridden block number is filled with empty blocks
+	block := blocks[len(blocks)-1]
+	if len(block.Calls) != len(txs) {
+		return nil, fmt.Errorf("unexpected number of simulated txs: %d, expected %d", len(block.Calls), len(txs))
+	}
+	return block, nil
+}
@@ -0,0 +1,129 @@
+package backend
+
+import (
+	"maps"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// lruCache is a bounded LRU cache counting its hits and misses. A nil lruCache
+// caches nothing.
+type lruCache[K comparable, V any] struct {
+	cache  *lru.Cache[K, V]
+	hits   *gethmetrics.Counter
+	misses *gethmetrics.Counter
+}
+
+func newLRUCache[K comparable, V any](name string, size int) *lruCache[K, V] {
+	cache, err := lru.New[K, V](size)
+	if err != nil {
+		// should not happen, the size is positive
+		panic(err)
+	}
+	return &lruCache[K, V]{
+		cache:  cache,
+		hits:   gethmetrics.GetOrRegisterCounter("rpc/cache/"+name+"/hit", nil),
+		misses: gethmetrics.GetOrRegisterCounter("rpc/cache/"+name+"/miss", nil),
+	}
+}
+
+func (c *lruCache[K, V]) get(key K) (value V, ok bool) {
+	if c == nil {
+		return value, false
+	}
+	value, ok = c.cache.Get(key)
+	if ok {
+		c.hits.Inc(1)
+	} else {
+		c.misses.Inc(1)
+	}
+	return value, ok
+}
+
+func (c *lruCache[K, V]) add(key K, value V) {
+	if c != nil {
+		c.cache.Add(key, value)
+	}
+}
+
+// responseCache caches the responses of the historical queries. CometBFT has
+// instant finality, so the blocks, results and transactions of a committed
+// height never change and can be served from memory. Only the responses of
+// committed heights are added, the pending transactions and the failed
+// queries are never cached.
+type responseCache struct {
+	blocks        *lruCache[int64, *cmtrpctypes.ResultBlock]
+	blockHeights  *lruCache[common.Hash, int64]
+	blockResults  *lruCache[int64, *cmtrpctypes.ResultBlockResults]
+	blooms        *lruCache[int64, ethtypes.Bloom]
+	txs           *lruCache[common.Hash, *rpctypes.RPCTransaction]
+	receipts      *lruCache[common.Hash, map[string]interface{}]
+	blockReceipts *lruCache[int64, []map[string]interface{}]
+}
+
+// newResponseCache creates a responseCache holding up to size entries of each
+// kind, the cache is disabled if size is 0.
+func newResponseCache(size int) *responseCache {
+	if size <= 0 {
+		return &responseCache{}
+	}
+	return &responseCache{
+		blocks:        newLRUCache[int64, *cmtrpctypes.ResultBlock]("blocks", size),
+		blockHeights:  newLRUCache[common.Hash, int64]("block_heights", size),
+		blockResults:  newLRUCache[int64, *cmtrpctypes.ResultBlockResults]("block_results", size),
+		blooms:        newLRUCache[int64, ethtypes.Bloom]("blooms", size),
+		txs:           newLRUCache[common.Hash, *rpctypes.RPCTransaction]("txs", size),
+		receipts:      newLRUCache[common.Hash, map[string]interface{}]("receipts", size),
+		blockReceipts: newLRUCache[int64, []map[string]interface{}]("block_receipts", size),
+	}
+}
+
+// getReceipt returns a copy of the cached receipt, so that the callers adding
+// fields to the receipt don't alter the cached one.
+func (c *responseCache) getReceipt(hash common.Hash) (map[string]interface{}, bool) {
+	receipt, ok := c.receipts.get(hash)
+	if !ok {
+		return nil, false
+	}
+	return maps.Clone(receipt), true
+}
+
+// getBlockReceipts returns a copy of the cached receipts of the block.
+func (c *responseCache) getBlockReceipts(height int64) ([]map[string]interface{}, bool) {
+	receipts, ok := c.blockReceipts.get(height)
+	if !ok {
+		return nil, false
+	}
+	res := make([]map[string]interface{}, len(receipts))
+	for i, receipt := range receipts {
+		res[i] = maps.Clone(receipt)
+	}
+	return res, true
+}
+
+// addReceipt caches a copy of the receipt of the transaction.
+func (c *responseCache) addReceipt(hash common.Hash, receipt map[string]interface{}) {
+	if c.receipts == nil {
+		return
+	}
+	c.receipts.add(hash, maps.Clone(receipt))
+}
+
+// addBlockReceipts caches a copy of the receipts of the block.
+func (c *responseCache) addBlockReceipts(height int64, receipts []map[string]interface{}) {
+	if c.blockReceipts == nil {
+		return
+	}
+	cached := make([]map[string]interface{}, len(receipts))
+	for i, receipt := range receipts {
+		cached[i] = maps.Clone(receipt)
+	}
+	c.blockReceipts.add(height, cached)
+}
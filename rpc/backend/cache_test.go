@@ -0,0 +1,81 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+func TestResponseCacheBlocks(t *testing.T) {
+	backend := setupMockBackend(t)
+	mockClient := backend.ClientCtx.Client.(*mocks.Client)
+
+	height := int64(5)
+	hash := common.HexToHash("0x01")
+	resBlock := &tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: hash.Bytes()},
+		Block:   &tmtypes.Block{Header: tmtypes.Header{Height: height}},
+	}
+	// the client is only queried once, the next queries are served from the cache
+	mockClient.On("Block", mock.Anything, &height).Return(resBlock, nil).Once()
+	mockClient.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{Height: height}, nil).Once()
+	// the metrics are shared by the backends of the package tests
+	blockHits := backend.cache.blocks.hits.Snapshot().Count()
+	heightHits := backend.cache.blockHeights.hits.Snapshot().Count()
+
+	for i := 0; i < 2; i++ {
+		res, err := backend.CometBlockByNumber(context.Background(), rpctypes.BlockNumber(height))
+		require.NoError(t, err)
+		require.Equal(t, resBlock, res)
+
+		blockRes, err := backend.CometBlockResultByNumber(context.Background(), &height)
+		require.NoError(t, err)
+		require.Equal(t, height, blockRes.Height)
+	}
+
+	res, err := backend.CometBlockByHash(context.Background(), hash)
+	require.NoError(t, err)
+	require.Equal(t, resBlock, res)
+
+	require.Equal(t, blockHits+2, backend.cache.blocks.hits.Snapshot().Count())
+	require.Equal(t, heightHits+1, backend.cache.blockHeights.hits.Snapshot().Count())
+}
+
+func TestResponseCacheReceipts(t *testing.T) {
+	cache := newResponseCache(2)
+	hash := common.HexToHash("0x01")
+
+	receipt := map[string]interface{}{"status": 1}
+	cache.addReceipt(hash, receipt)
+	receipt["timestamp"] = 10
+
+	// the cached receipt is not altered by the callers
+	cached, ok := cache.getReceipt(hash)
+	require.True(t, ok)
+	require.Equal(t, map[string]interface{}{"status": 1}, cached)
+	cached["timestamp"] = 10
+	cached, ok = cache.getReceipt(hash)
+	require.True(t, ok)
+	require.Len(t, cached, 1)
+
+	// the least recently used entries are evicted
+	cache.addReceipt(common.HexToHash("0x02"), receipt)
+	cache.addReceipt(common.HexToHash("0x03"), receipt)
+	_, ok = cache.getReceipt(hash)
+	require.False(t, ok)
+
+	// a disabled cache holds nothing
+	cache = newResponseCache(0)
+	cache.addReceipt(hash, receipt)
+	_, ok = cache.getReceipt(hash)
+	require.False(t, ok)
+}
@@ -114,27 +114,9 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 
 	span.SetAttributes(attribute.String("tx_hash", tx.Hash().Hex()))
 
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() {
-		if !tx.Protected() {
-			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-			return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-		}
-		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
-			return common.Hash{}, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
-		}
-	}
-
-	ethereumTx := &evmtypes.MsgEthereumTx{}
-	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
-	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
-		b.Logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to convert ethereum transaction: %w", err)
-	}
-
-	if err := ethereumTx.ValidateBasic(); err != nil {
-		b.Logger.Debug("tx failed basic validation", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to validate transaction: %w", err)
+	ethereumTx, err := b.validateRawTransaction(tx)
+	if err != nil {
+		return common.Hash{}, err
 	}
 
 	baseDenom := evmtypes.GetEVMCoinDenom()
@@ -164,6 +146,34 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 	return txHash, nil
 }
 
+// validateRawTransaction checks that the raw transaction can be submitted over
+// RPC and converts it to its message.
+func (b *Backend) validateRawTransaction(tx *ethtypes.Transaction) (*evmtypes.MsgEthereumTx, error) {
+	// check the local node config in case unprotected txs are disabled
+	if !b.UnprotectedAllowed() {
+		if !tx.Protected() {
+			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+			return nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
+		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
+			return nil, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
+		}
+	}
+
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
+	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
+		b.Logger.Error("transaction converting failed", "error", err.Error())
+		return nil, fmt.Errorf("failed to convert ethereum transaction: %w", err)
+	}
+
+	if err := ethereumTx.ValidateBasic(); err != nil {
+		b.Logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, fmt.Errorf("failed to validate transaction: %w", err)
+	}
+	return ethereumTx, nil
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (result evmtypes.TransactionArgs, err error) {
@@ -309,13 +319,20 @@ func (b *Backend) EstimateGas(
 	ctx, span := tracer.Start(ctx, "EstimateGas", trace.WithAttributes(attribute.String("from", args.GetFrom().Hex()), attribute.String("to", toAddr)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	// the pending txs are only applied if the pending block is requested, the
+	// default block is estimated on the latest state
 	blockNr := rpctypes.EthPendingBlockNumber
+	var pendingTxs []*evmtypes.MsgEthereumTx
 	if blockNrOrHash != nil {
 		var err error
 		blockNr, err = b.BlockNumberFromComet(ctx, *blockNrOrHash)
 		if err != nil {
 			return 0, err
 		}
+		pendingTxs, err = b.pendingMsgs(ctx, blockNr)
+		if err != nil {
+			return 0, err
+		}
 	}
 
 	bz, err := json.Marshal(&args)
@@ -340,6 +357,7 @@ func (b *Backend) EstimateGas(
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 		Overrides:       bzOverrides,
+		PendingTxs:      pendingTxs,
 	}
 
 	// From NewContextWithHeight: if the provided height is 0,
@@ -386,12 +404,18 @@ func (b *Backend) DoCall(
 		bzOverrides = *overrides
 	}
 
+	pendingTxs, err := b.pendingMsgs(ctx, blockNr)
+	if err != nil {
+		return nil, err
+	}
+
 	req := evmtypes.EthCallRequest{
 		Args:            bz,
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 		Overrides:       bzOverrides,
+		PendingTxs:      pendingTxs,
 	}
 
 	// From NewContextWithHeight: if the provided height is 0,
@@ -425,6 +449,88 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 executes series of calls on top of the given block, with
+// optional block and state overrides, as specified by eth_simulateV1.
+func (b *Backend) SimulateV1(
+	ctx context.Context,
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (result []map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "SimulateV1", trace.WithAttributes(attribute.Int("blocks", len(opts.BlockStateCalls))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, rpctypes.NewSimError(rpctypes.ErrCodeInvalidParams, "empty input")
+	} else if len(opts.BlockStateCalls) > rpctypes.MaxSimulateBlocks {
+		return nil, rpctypes.NewSimError(rpctypes.ErrCodeClientLimitExceeded, "too many blocks")
+	}
+
+	blockNr := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		blockNr, err = b.BlockNumberFromComet(ctx, *blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	blocks, err := b.simulate(ctx, opts, blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]map[string]interface{}, len(blocks))
+	for i, block := range blocks {
+		result[i] = rpctypes.RPCMarshalSimBlock(block, opts.ReturnFullTransactions, b.ChainConfig())
+	}
+	return result, nil
+}
+
+// simulate executes the simulation on top of the block through the EVM
+// module and returns the simulated blocks.
+func (b *Backend) simulate(ctx context.Context, opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]*rpctypes.SimBlockResult, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CometHeaderByNumber(ctx, blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+	}
+
+	ctx = rpctypes.ContextWithHeight(ctx, blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, rpctypes.NewSimError(int(res.ErrorCode), res.Error)
+	}
+
+	var blocks []*rpctypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &blocks); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice(ctx context.Context) (result *hexutil.Big, err error) {
 	ctx, span := tracer.Start(ctx, "GasPrice")
@@ -344,9 +344,9 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// SuggestGasTipCap returns the suggested tip cap, which is the configured percentile of the lowest
+// effective tips paid in the recent blocks. The maximum base fee change of a block is suggested while
+// no tip has been sampled, to help client to mitigate the base fee changes.
 func (b *Backend) SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (_ *big.Int, err error) {
 	ctx, span := tracer.Start(ctx, "SuggestGasTipCap")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
@@ -374,5 +374,11 @@ func (b *Backend) SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (_ *bi
 		// impossible if the parameter validation passed.
 		maxDelta = 0
 	}
-	return big.NewInt(maxDelta), nil
+
+	head, err := b.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the max delta is suggested until the oracle samples a tip from the recent blocks
+	return b.gasOracle.suggestTipCap(ctx, int64(head), big.NewInt(maxDelta), b.sampleBlockTips) //nolint:gosec // G115 // won't exceed int64
 }
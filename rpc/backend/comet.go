@@ -26,6 +26,9 @@ func (b *Backend) CometBlockByNumber(ctx context.Context, blockNum rpctypes.Bloc
 	if err != nil {
 		return nil, err
 	}
+	if resBlock, ok := b.cache.blocks.get(height); ok {
+		return resBlock, nil
+	}
 	resBlock, err := b.RPCClient.Block(ctx, &height)
 	if err != nil {
 		b.Logger.Debug("cometbft client failed to get block", "height", height, "error", err.Error())
@@ -37,6 +40,7 @@ func (b *Backend) CometBlockByNumber(ctx context.Context, blockNum rpctypes.Bloc
 		return nil, nil
 	}
 
+	b.cacheBlock(resBlock)
 	return resBlock, nil
 }
 
@@ -66,7 +70,7 @@ func (b *Backend) CometBlockResultByNumber(ctx context.Context, height *int64) (
 	if height != nil && *height == 0 {
 		height = nil
 	}
-	res, err := b.RPCClient.BlockResults(ctx, height)
+	res, err := b.blockResults(ctx, height)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch block result from CometBFT %d: %w", heightAttr, err)
 	}
@@ -79,6 +83,11 @@ func (b *Backend) CometBlockByHash(ctx context.Context, blockHash common.Hash) (
 	ctx, span := tracer.Start(ctx, "CometBlockByHash", trace.WithAttributes(attribute.String("blockHash", blockHash.Hex())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if height, ok := b.cache.blockHeights.get(blockHash); ok {
+		if resBlock, ok := b.cache.blocks.get(height); ok {
+			return resBlock, nil
+		}
+	}
 	resBlock, err := b.RPCClient.BlockByHash(ctx, blockHash.Bytes())
 	if err != nil {
 		b.Logger.Debug("CometBFT client failed to get block", "blockHash", blockHash.Hex(), "error", err.Error())
@@ -90,9 +99,33 @@ func (b *Backend) CometBlockByHash(ctx context.Context, blockHash common.Hash) (
 		return nil, fmt.Errorf("block not found for hash %s", blockHash.Hex())
 	}
 
+	b.cacheBlock(resBlock)
 	return resBlock, nil
 }
 
+// cacheBlock adds the committed block to the response cache.
+func (b *Backend) cacheBlock(resBlock *cmtrpctypes.ResultBlock) {
+	b.cache.blocks.add(resBlock.Block.Height, resBlock)
+	b.cache.blockHeights.add(common.BytesToHash(resBlock.BlockID.Hash), resBlock.Block.Height)
+}
+
+// blockResults returns the CometBFT block results at the height, or at the
+// latest height if height is nil. The results of an explicit height are
+// served from the response cache.
+func (b *Backend) blockResults(ctx context.Context, height *int64) (*cmtrpctypes.ResultBlockResults, error) {
+	if height != nil {
+		if res, ok := b.cache.blockResults.get(*height); ok {
+			return res, nil
+		}
+	}
+	res, err := b.RPCClient.BlockResults(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	b.cache.blockResults.add(res.Height, res)
+	return res, nil
+}
+
 func (b *Backend) getHeightByBlockNum(ctx context.Context, blockNum rpctypes.BlockNumber) (height int64, err error) {
 	ctx, span := tracer.Start(ctx, "getHeightByBlockNum", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
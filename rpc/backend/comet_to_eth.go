@@ -358,6 +358,10 @@ func (b *Backend) BlockBloomFromCometBlock(ctx context.Context, blockRes *cmtrpc
 	_, span := tracer.Start(ctx, "BlockBloomFromCometBlock")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if bloom, ok := b.cache.blooms.get(blockRes.Height); ok {
+		return bloom, nil
+	}
+
 	for _, event := range blockRes.FinalizeBlockEvents {
 		if event.Type != evmtypes.EventTypeBlockBloom {
 			continue
@@ -365,7 +369,9 @@ func (b *Backend) BlockBloomFromCometBlock(ctx context.Context, blockRes *cmtrpc
 
 		for _, attr := range event.Attributes {
 			if attr.Key == evmtypes.AttributeKeyEthereumBloom {
-				return ethtypes.BytesToBloom([]byte(attr.Value)), nil
+				bloom := ethtypes.BytesToBloom([]byte(attr.Value))
+				b.cache.blooms.add(blockRes.Height, bloom)
+				return bloom, nil
 			}
 		}
 	}
@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	servertypes "github.com/cosmos/evm/server/types"
 	evmtrace "github.com/cosmos/evm/trace"
 )
 
@@ -36,8 +37,18 @@ func (b *Backend) GetLogsByHeight(ctx context.Context, height *int64) (result []
 	ctx, span := tracer.Start(ctx, "GetLogsByHeight", trace.WithAttributes(attribute.Int64("height", heightAttr)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if idxer, ok := b.Indexer.(servertypes.EVMLogIndexer); ok && height != nil {
+		logs, indexed, err := idxer.GetLogsByHeight(*height)
+		if err != nil {
+			return nil, err
+		}
+		if indexed {
+			return logs, nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
-	blockRes, err := b.RPCClient.BlockResults(ctx, height)
+	blockRes, err := b.blockResults(ctx, height)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +56,20 @@ func (b *Backend) GetLogsByHeight(ctx context.Context, height *int64) (result []
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetLogBlocks returns the heights of the blocks in the [from, to] range with
+// logs matching the addresses and topics, using the log indexes of the EVM tx
+// indexer. The boolean result is false when the indexes don't cover the range.
+func (b *Backend) GetLogBlocks(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) (heights []int64, indexed bool, err error) {
+	_, span := tracer.Start(ctx, "GetLogBlocks", trace.WithAttributes(attribute.Int64("from", from), attribute.Int64("to", to)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, ok := b.Indexer.(servertypes.EVMLogIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+	return idxer.GetLogBlocks(from, to, addresses, topics)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -0,0 +1,150 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// gpoSampleNumber is the number of the lowest effective tips sampled from each block.
+const gpoSampleNumber = 3
+
+// blockTipsFetcher returns the lowest effective tips, in ascending order, paid
+// by the ethereum transactions of the block at the given height.
+type blockTipsFetcher func(ctx context.Context, height int64) ([]*big.Int, error)
+
+// gasPriceOracle suggests the priority fee from the effective tips paid in the
+// recent blocks, as done by the geth percentile oracle. The suggestion is
+// cached per head height and the samples of each block are cached as the
+// committed blocks never change. A nil gasPriceOracle suggests the fallback
+// price.
+type gasPriceOracle struct {
+	blocks      int
+	percentile  int
+	maxPrice    *big.Int
+	ignorePrice *big.Int
+
+	samples *lruCache[int64, []*big.Int]
+
+	mu        sync.Mutex
+	lastHead  int64
+	lastPrice *big.Int
+}
+
+func newGasPriceOracle(cfg config.GasPriceOracleConfig) *gasPriceOracle {
+	if cfg.Blocks < 1 {
+		return nil
+	}
+	return &gasPriceOracle{
+		blocks:      cfg.Blocks,
+		percentile:  cfg.Percentile,
+		maxPrice:    new(big.Int).SetUint64(cfg.MaxPrice),
+		ignorePrice: new(big.Int).SetUint64(cfg.IgnorePrice),
+		samples:     newLRUCache[int64, []*big.Int]("gas_price_oracle", cfg.Blocks),
+	}
+}
+
+// suggestTipCap returns the configured percentile of the tips sampled from the
+// blocks up to head, capped to the max price. The blocks without samples count
+// as the last suggestion, which is the fallback price until a block with
+// samples is found.
+func (o *gasPriceOracle) suggestTipCap(ctx context.Context, head int64, fallback *big.Int, fetch blockTipsFetcher) (*big.Int, error) {
+	if o == nil {
+		return fallback, nil
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.lastPrice != nil && o.lastHead == head {
+		return new(big.Int).Set(o.lastPrice), nil
+	}
+	lastPrice := o.lastPrice
+	if lastPrice == nil {
+		lastPrice = fallback
+	}
+
+	// the blocks are visited in ascending order, so that the oldest samples are evicted first
+	var results []*big.Int
+	for height := max(head-int64(o.blocks)+1, 1); height <= head; height++ {
+		tips, ok := o.samples.get(height)
+		if !ok {
+			var err error
+			if tips, err = fetch(ctx, height); err != nil {
+				return nil, err
+			}
+			o.samples.add(height, tips)
+		}
+		if len(tips) == 0 {
+			results = append(results, lastPrice)
+			continue
+		}
+		results = append(results, tips...)
+	}
+
+	price := lastPrice
+	if len(results) > 0 {
+		slices.SortFunc(results, (*big.Int).Cmp)
+		price = results[(len(results)-1)*o.percentile/100]
+	}
+	if price.Cmp(o.maxPrice) > 0 {
+		price = new(big.Int).Set(o.maxPrice)
+	}
+
+	o.lastHead = head
+	o.lastPrice = price
+	return new(big.Int).Set(price), nil
+}
+
+// sampleBlockTips returns the lowest effective tips of the ethereum
+// transactions of the block, skipping the tips below the ignore price. It
+// decodes the same block data as ProcessBlock does for eth_feeHistory.
+func (b *Backend) sampleBlockTips(ctx context.Context, height int64) ([]*big.Int, error) {
+	cometBlock, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if cometBlock == nil || len(cometBlock.Block.Txs) == 0 {
+		return nil, nil
+	}
+
+	cometBlockResult, err := b.CometBlockResultByNumber(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	blockBaseFee, err := b.BaseFee(ctx, cometBlockResult)
+	if err != nil {
+		b.Logger.Debug("failed to get block base fee", "height", height, "error", err.Error())
+	}
+
+	var tips []*big.Int
+	for _, cometTx := range cometBlock.Block.Txs {
+		tx, err := b.ClientCtx.TxConfig.TxDecoder()(cometTx)
+		if err != nil {
+			b.Logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			tip, err := ethMsg.AsTransaction().EffectiveGasTip(blockBaseFee)
+			if err != nil || tip.Cmp(b.gasOracle.ignorePrice) < 0 {
+				continue
+			}
+			tips = append(tips, tip)
+		}
+	}
+
+	slices.SortFunc(tips, (*big.Int).Cmp)
+	if len(tips) > gpoSampleNumber {
+		tips = tips[:gpoSampleNumber]
+	}
+	return tips, nil
+}
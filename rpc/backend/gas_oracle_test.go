@@ -0,0 +1,66 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func TestGasPriceOracleSuggestTipCap(t *testing.T) {
+	cfg := config.GasPriceOracleConfig{Blocks: 3, Percentile: 50, MaxPrice: 100, IgnorePrice: 2}
+	blockTips := map[int64][]*big.Int{
+		8:  {big.NewInt(5), big.NewInt(50)},
+		9:  {big.NewInt(10)},
+		10: nil,
+	}
+	fetches := 0
+	fetch := func(_ context.Context, height int64) ([]*big.Int, error) {
+		fetches++
+		return blockTips[height], nil
+	}
+	fallback := big.NewInt(1)
+
+	oracle := newGasPriceOracle(cfg)
+	// samples 5, 10, 50 and the fallback price for the empty block 10
+	price, err := oracle.suggestTipCap(context.Background(), 10, fallback, fetch)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), price)
+	require.Equal(t, 3, fetches)
+
+	// the suggestion is cached for the head
+	price, err = oracle.suggestTipCap(context.Background(), 10, fallback, fetch)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), price)
+	require.Equal(t, 3, fetches)
+
+	// only the new block is fetched, the empty blocks count as the last suggestion
+	blockTips[11] = []*big.Int{big.NewInt(200)}
+	price, err = oracle.suggestTipCap(context.Background(), 11, fallback, fetch)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), price)
+	require.Equal(t, 4, fetches)
+
+	// the suggestion is capped to the max price
+	oracle = newGasPriceOracle(config.GasPriceOracleConfig{Blocks: 1, Percentile: 60, MaxPrice: 100})
+	price, err = oracle.suggestTipCap(context.Background(), 11, fallback, fetch)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), price)
+
+	// the fetch errors are returned
+	oracle = newGasPriceOracle(cfg)
+	_, err = oracle.suggestTipCap(context.Background(), 12, fallback, func(context.Context, int64) ([]*big.Int, error) {
+		return nil, errors.New("block not found")
+	})
+	require.ErrorContains(t, err, "block not found")
+
+	// a disabled oracle suggests the fallback price
+	oracle = newGasPriceOracle(config.GasPriceOracleConfig{})
+	price, err = oracle.suggestTipCap(context.Background(), 12, fallback, fetch)
+	require.NoError(t, err)
+	require.Equal(t, fallback, price)
+}
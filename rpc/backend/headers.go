@@ -30,7 +30,7 @@ func (b *Backend) GetHeaderByNumber(ctx context.Context, blockNum rpctypes.Block
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "height", blockNum, "error", err.Error())
 		return nil, nil
@@ -61,7 +61,7 @@ func (b *Backend) GetHeaderByHash(ctx context.Context, hash common.Hash) (result
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "block-hash", hash.String(), "error", err.Error())
 		return nil, nil
@@ -119,7 +119,7 @@ func (b *Backend) HeaderByHash(ctx context.Context, blockHash common.Hash) (resu
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "block-hash", blockHash.String(), "error", err.Error())
 		return nil, nil
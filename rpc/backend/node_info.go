@@ -2,8 +2,10 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,6 +15,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/keystore"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
@@ -36,6 +39,14 @@ import (
 func (b *Backend) Accounts() ([]common.Address, error) {
 	addresses := make([]common.Address, 0) // return [] instead of nil if empty
 
+	if b.externalSigner != nil {
+		accounts, err := b.externalSigner.accounts(context.Background())
+		if err != nil {
+			return addresses, err
+		}
+		return append(addresses, accounts...), nil
+	}
+
 	if !b.Cfg.JSONRPC.AllowInsecureUnlock {
 		b.Logger.Debug("account unlock with HTTP access is forbidden")
 		return addresses, fmt.Errorf("account unlock with HTTP access is forbidden")
@@ -203,8 +214,55 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 		return common.Address{}, err
 	}
 
-	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
+	return b.importKey(&ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}, password)
+}
+
+// ImportKeystore decrypts an Ethereum keystore file, whose key is derived with
+// either scrypt or PBKDF2, and stores its key into the key directory as done by
+// ImportRawKey.
+func (b *Backend) ImportKeystore(keyJSON, password string) (common.Address, error) {
+	privKey, err := keystore.DecryptKey([]byte(keyJSON), password)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return b.importKey(privKey, password)
+}
+
+// ExportKeystore exports the key of address as a version 3 Ethereum keystore
+// file, encrypted with the password and a scrypt derived key. The keyring
+// doesn't encrypt its keys with a passphrase of their own, so the export is
+// only allowed when the calls to the personal namespace require a JWT.
+func (b *Backend) ExportKeystore(address common.Address, password string) (json.RawMessage, error) {
+	if !b.Cfg.JSONRPC.AllowInsecureUnlock {
+		b.Logger.Debug("account unlock with HTTP access is forbidden")
+		return nil, fmt.Errorf("account unlock with HTTP access is forbidden")
+	}
+	if b.Cfg.JSONRPC.JWTSecret == "" || !slices.Contains(b.Cfg.JSONRPC.JWTNamespaces, "personal") {
+		b.Logger.Debug("keystore export without JWT authentication is forbidden")
+		return nil, fmt.Errorf("keystore export requires the JWT authentication of the personal namespace")
+	}
 
+	armor, err := b.ClientCtx.Keyring.ExportPrivKeyArmorByAddress(sdk.AccAddress(address.Bytes()), password)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, algo, err := sdkcrypto.UnarmorDecryptPrivKey(armor, password)
+	if err != nil {
+		return nil, err
+	}
+
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok || algo != ethsecp256k1.KeyType {
+		return nil, fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+	}
+
+	return keystore.EncryptKey(ethPrivKey, password, keystore.KDFScrypt)
+}
+
+// importKey armors and encrypts the key with the password and stores it into
+// the key directory, unless it has already been imported.
+func (b *Backend) importKey(privKey *ethsecp256k1.PrivKey, password string) (common.Address, error) {
 	addr := sdk.AccAddress(privKey.PubKey().Address().Bytes())
 	ethereumAddr := common.BytesToAddress(addr)
 
@@ -324,6 +382,16 @@ func (b *Backend) RPCTxFeeCap() float64 {
 	return b.Cfg.JSONRPC.TxFeeCap
 }
 
+// RPCTxSyncDefaultTimeout is the default time eth_sendRawTransactionSync waits for the receipt.
+func (b *Backend) RPCTxSyncDefaultTimeout() time.Duration {
+	return b.Cfg.JSONRPC.TxSyncDefaultTimeout
+}
+
+// RPCTxSyncMaxTimeout is the max time eth_sendRawTransactionSync waits for the receipt.
+func (b *Backend) RPCTxSyncMaxTimeout() time.Duration {
+	return b.Cfg.JSONRPC.TxSyncMaxTimeout
+}
+
 // RPCFeeHistoryCap is the limit for total number of blocks that can be fetched
 func (b *Backend) RPCFeeHistoryCap() int32 {
 	return b.Cfg.JSONRPC.FeeHistoryCap
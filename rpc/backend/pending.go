@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// PendingBlock returns the pending block, made of the txs the mempool would
+// select for the next block on top of the latest block. It returns nil if the
+// mempool cannot select the pending txs.
+func (b *Backend) PendingBlock(ctx context.Context) (result *ethtypes.Block, err error) {
+	ctx, span := tracer.Start(ctx, "PendingBlock")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	pm, ok := b.Mempool.(PendingMempool)
+	if !ok {
+		return nil, nil
+	}
+
+	resBlock, err := b.CometBlockByNumber(ctx, types.EthLatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("latest block not found")
+	}
+	blockRes, err := b.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := b.EthBlockFromCometBlock(ctx, resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	header := ethtypes.CopyHeader(latest.Header())
+	header.ParentHash = common.BytesToHash(resBlock.BlockID.Hash)
+	header.Number = new(big.Int).Add(header.Number, big.NewInt(1))
+	header.Time = max(uint64(time.Now().Unix()), header.Time+1) //nolint:gosec // G115
+	header.Root = ethtypes.EmptyRootHash
+	header.GasUsed = 0
+
+	txs := pm.PendingEVMTxs(ctx, header.GasLimit)
+	for _, tx := range txs {
+		header.GasUsed += tx.Gas()
+	}
+
+	body := &ethtypes.Body{
+		Transactions: txs,
+		Uncles:       []*ethtypes.Header{},
+		Withdrawals:  []*ethtypes.Withdrawal{},
+	}
+	return ethtypes.NewBlock(header, body, nil, trie.NewStackTrie(nil)), nil
+}
+
+// pendingMsgs returns the txs of the pending block to apply before a query at
+// blockNum, which are only set for the pending block number.
+func (b *Backend) pendingMsgs(ctx context.Context, blockNum types.BlockNumber) ([]*evmtypes.MsgEthereumTx, error) {
+	if blockNum != types.EthPendingBlockNumber {
+		return nil, nil
+	}
+	block, err := b.PendingBlock(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	txs := block.Transactions()
+	msgs := make([]*evmtypes.MsgEthereumTx, len(txs))
+	for i, tx := range txs {
+		msgs[i] = &evmtypes.MsgEthereumTx{}
+		msgs[i].FromEthereumTx(tx)
+	}
+	return msgs, nil
+}
@@ -0,0 +1,163 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel/attribute"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+)
+
+// privateTxForwardTimeout bounds the calls forwarding the private txs to the
+// trusted peers.
+const privateTxForwardTimeout = 5 * time.Second
+
+// SendPrivateTransaction adds a signed tx to the mempool without broadcasting
+// it to the network, and forwards it to the trusted peers. The tx is only
+// included in the blocks proposed by this node and the trusted peers, up to
+// its max block number.
+func (b *Backend) SendPrivateTransaction(ctx context.Context, args rpctypes.PrivateTxArgs) (result common.Hash, err error) {
+	ctx, span := tracer.Start(ctx, "SendPrivateTransaction")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	pm, ok := b.Mempool.(PrivateMempool)
+	if !ok {
+		return common.Hash{}, errors.New("private transactions are not supported by the mempool")
+	}
+
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(args.Tx); err != nil {
+		b.Logger.Error("transaction decoding failed", "error", err.Error())
+		return common.Hash{}, err
+	}
+
+	span.SetAttributes(attribute.String("tx_hash", tx.Hash().Hex()))
+
+	if _, err := b.validateRawTransaction(tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	var maxBlockNumber uint64
+	if args.MaxBlockNumber != nil {
+		maxBlockNumber = uint64(*args.MaxBlockNumber)
+	}
+	if err := pm.InsertPrivateTx(tx, maxBlockNumber); err != nil {
+		return common.Hash{}, err
+	}
+
+	b.forwardPrivate("eth_sendPrivateTransaction", args)
+	return tx.Hash(), nil
+}
+
+// CancelPrivateTransaction drops a private tx from the mempool and from the
+// mempools of the trusted peers. The cancellation must be signed by the sender
+// of the tx. It returns false if the mempool holds no tx with the hash sent by
+// the signer.
+func (b *Backend) CancelPrivateTransaction(ctx context.Context, args rpctypes.CancelPrivateTxArgs) (result bool, err error) {
+	_, span := tracer.Start(ctx, "CancelPrivateTransaction")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	pm, ok := b.Mempool.(PrivateMempool)
+	if !ok {
+		return false, errors.New("private transactions are not supported by the mempool")
+	}
+
+	from, err := cancelSigner(args)
+	if err != nil {
+		return false, err
+	}
+	if !pm.CancelPrivateTx(args.TxHash, from) {
+		return false, nil
+	}
+	// only the cancellations of the held txs are forwarded, so that peers
+	// forwarding to each other don't forward them back and forth
+	b.forwardPrivate("eth_cancelPrivateTransaction", args)
+	return true, nil
+}
+
+// cancelSigner returns the address which signed the cancellation of the
+// private tx. The [R || S || V] signature is the EIP-191 signature of the tx
+// hash, with V in its 27/28 form as returned by personal_sign, or 0/1.
+func cancelSigner(args rpctypes.CancelPrivateTxArgs) (common.Address, error) {
+	if len(args.Signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature must be %d bytes long", crypto.SignatureLength)
+	}
+
+	sig := bytes.Clone(args.Signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27 // Transform V from 27/28 to 0/1 to recover the public key
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash(args.TxHash.Bytes()), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// forwardPrivate calls the method on the trusted peers in the background. The
+// failures are logged, since the tx is held by this node either way. The calls
+// are authenticated with a JWT if WithPrivateTxAuth is set.
+func (b *Backend) forwardPrivate(method string, args interface{}) {
+	for _, peer := range b.privateTxPeers {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), privateTxForwardTimeout)
+			defer cancel()
+
+			var res interface{}
+			if err := peer.call(ctx, &res, method, args); err != nil {
+				b.Logger.Debug("failed to forward private transaction", "method", method, "peer", peer.endpoint, "err", err)
+			}
+		}()
+	}
+}
+
+// privateTxPeer forwards the private txs to the JSON-RPC server of a trusted
+// validator. The peer is dialed on the first call so that the node does not
+// depend on the peer being up when it starts.
+type privateTxPeer struct {
+	endpoint string
+	// auth authenticates the calls, if set
+	auth rpc.HTTPAuth
+
+	mu     sync.Mutex
+	client *rpc.Client
+}
+
+func newPrivateTxPeers(endpoints []string) []*privateTxPeer {
+	peers := make([]*privateTxPeer, len(endpoints))
+	for i, endpoint := range endpoints {
+		peers[i] = &privateTxPeer{endpoint: endpoint}
+	}
+	return peers
+}
+
+func (p *privateTxPeer) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	p.mu.Lock()
+	if p.client == nil {
+		var opts []rpc.ClientOption
+		if p.auth != nil {
+			opts = append(opts, rpc.WithHTTPAuth(p.auth))
+		}
+		client, err := rpc.DialOptions(ctx, p.endpoint, opts...)
+		if err != nil {
+			p.mu.Unlock()
+			return fmt.Errorf("failed to dial private tx peer: %w", err)
+		}
+		p.client = client
+	}
+	client := p.client
+	p.mu.Unlock()
+
+	return client.CallContext(ctx, result, method, args...)
+}
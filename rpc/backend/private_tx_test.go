@@ -0,0 +1,38 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+func TestCancelSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	hash := common.HexToHash("0x01")
+
+	sig, err := crypto.Sign(accounts.TextHash(hash.Bytes()), key)
+	require.NoError(t, err)
+	from, err := cancelSigner(rpctypes.CancelPrivateTxArgs{TxHash: hash, Signature: sig})
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), from)
+
+	// the personal_sign form of V is accepted
+	sig[crypto.RecoveryIDOffset] += 27
+	from, err = cancelSigner(rpctypes.CancelPrivateTxArgs{TxHash: hash, Signature: sig})
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), from)
+
+	// a signature of another hash recovers another address
+	from, err = cancelSigner(rpctypes.CancelPrivateTxArgs{TxHash: common.HexToHash("0x02"), Signature: sig})
+	require.NoError(t, err)
+	require.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), from)
+
+	_, err = cancelSigner(rpctypes.CancelPrivateTxArgs{TxHash: hash})
+	require.ErrorContains(t, err, "signature must be 65 bytes long")
+}
@@ -1,20 +1,25 @@
 package backend
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -22,7 +27,8 @@ import (
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
-// SendTransaction sends transaction based on received args using Node's key to sign it
+// SendTransaction sends transaction based on received args using Node's key to sign it,
+// or the external signer if one is configured
 func (b *Backend) SendTransaction(ctx context.Context, args evmtypes.TransactionArgs) (result common.Hash, err error) {
 	var toAddr string
 	if args.To != nil {
@@ -31,47 +37,8 @@ func (b *Backend) SendTransaction(ctx context.Context, args evmtypes.Transaction
 	ctx, span := tracer.Start(ctx, "SendTransaction", trace.WithAttributes(attribute.String("from", args.GetFrom().Hex()), attribute.String("to", toAddr)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
-	// Look up the wallet containing the requested signer
-	if !b.Cfg.JSONRPC.AllowInsecureUnlock {
-		b.Logger.Debug("account unlock with HTTP access is forbidden")
-		return common.Hash{}, fmt.Errorf("account unlock with HTTP access is forbidden")
-	}
-
-	_, err = b.ClientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.Logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
-	}
-
-	if args.ChainID != nil && (b.EvmChainID).Cmp((*big.Int)(args.ChainID)) != 0 {
-		return common.Hash{}, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.EvmChainID))
-	}
-
-	args, err = b.SetTxDefaults(ctx, args)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	bn, err := b.BlockNumber(ctx)
+	msg, err := b.signTransaction(ctx, args)
 	if err != nil {
-		b.Logger.Debug("failed to fetch latest block number", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	header, err := b.CurrentHeader(ctx)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), header.Time)
-
-	// LegacyTx derives EvmChainID from the signature. To make sure the msg.ValidateBasic makes
-	// the corresponding EvmChainID validation, we need to sign the transaction before calling it
-
-	// Sign transaction
-	msg := evmtypes.NewTxFromArgs(&args)
-	if err := msg.Sign(signer, b.ClientCtx.Keyring); err != nil {
-		b.Logger.Debug("failed to sign tx", "error", err.Error())
 		return common.Hash{}, err
 	}
 
@@ -116,8 +83,125 @@ func (b *Backend) SendTransaction(ctx context.Context, args evmtypes.Transaction
 	return txHash, nil
 }
 
+// SignTransaction signs the transaction built from args without broadcasting
+// it. As done by geth, the gas, fees and nonce must be set by the caller.
+func (b *Backend) SignTransaction(ctx context.Context, args evmtypes.TransactionArgs) (result *rpctypes.SignTransactionResult, err error) {
+	ctx, span := tracer.Start(ctx, "SignTransaction", trace.WithAttributes(attribute.String("from", args.GetFrom().Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if args.Gas == nil {
+		return nil, errors.New("gas not specified")
+	}
+	if args.GasPrice == nil && (args.MaxPriorityFeePerGas == nil || args.MaxFeePerGas == nil) {
+		return nil, errors.New("missing gasPrice or maxFeePerGas/maxPriorityFeePerGas")
+	}
+	if args.Nonce == nil {
+		return nil, errors.New("nonce not specified")
+	}
+
+	msg, err := b.signTransaction(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := msg.AsTransaction()
+	if err := rpctypes.CheckTxFee(signed.GasPrice(), signed.Gas(), b.RPCTxFeeCap()); err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.SignTransactionResult{Raw: raw, Tx: signed}, nil
+}
+
+// signTransaction sets the defaults of args and signs the resulting
+// transaction with the key of the sender in the keyring or with the external
+// signer.
+func (b *Backend) signTransaction(ctx context.Context, args evmtypes.TransactionArgs) (*evmtypes.MsgEthereumTx, error) {
+	if b.externalSigner == nil {
+		// Look up the wallet containing the requested signer
+		if !b.Cfg.JSONRPC.AllowInsecureUnlock {
+			b.Logger.Debug("account unlock with HTTP access is forbidden")
+			return nil, fmt.Errorf("account unlock with HTTP access is forbidden")
+		}
+
+		_, err := b.ClientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
+		if err != nil {
+			b.Logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
+			return nil, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+		}
+	}
+
+	if args.ChainID != nil && (b.EvmChainID).Cmp((*big.Int)(args.ChainID)) != 0 {
+		return nil, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.EvmChainID))
+	}
+
+	args, err := b.SetTxDefaults(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	bn, err := b.BlockNumber(ctx)
+	if err != nil {
+		b.Logger.Debug("failed to fetch latest block number", "error", err.Error())
+		return nil, err
+	}
+
+	header, err := b.CurrentHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), header.Time)
+
+	if b.externalSigner != nil {
+		tx, err := b.externalSigner.signTransaction(ctx, args.GetFrom(), args.ToTransaction(ethtypes.LegacyTxType), b.EvmChainID)
+		if err != nil {
+			b.Logger.Debug("external signer failed to sign tx", "error", err.Error())
+			return nil, err
+		}
+		// the signer may not be trusted to sign with the requested account
+		from, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature from the external signer: %w", err)
+		}
+		if from != args.GetFrom() {
+			return nil, fmt.Errorf("external signer signed with %s instead of the requested from address %s", from, args.GetFrom())
+		}
+
+		msg := &evmtypes.MsgEthereumTx{}
+		if err := msg.FromSignedEthereumTx(tx, signer); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	}
+
+	// LegacyTx derives EvmChainID from the signature. To make sure the msg.ValidateBasic makes
+	// the corresponding EvmChainID validation, we need to sign the transaction before calling it
+
+	// Sign transaction
+	msg := evmtypes.NewTxFromArgs(&args)
+	if err := msg.Sign(signer, b.ClientCtx.Keyring); err != nil {
+		b.Logger.Debug("failed to sign tx", "error", err.Error())
+		return nil, err
+	}
+	return msg, nil
+}
+
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if b.externalSigner != nil {
+		signature, err := b.externalSigner.signData(context.Background(), address, data)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifySignature(address, accounts.TextHash(data), signature); err != nil {
+			return nil, err
+		}
+		return signature, nil
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
 	_, err := b.ClientCtx.Keyring.KeyByAddress(from)
@@ -139,19 +223,30 @@ func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Byte
 
 // SignTypedData signs EIP-712 conformant typed data
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.externalSigner != nil {
+		signature, err := b.externalSigner.signTypedData(context.Background(), address, typedData)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifySignature(address, sigHash, signature); err != nil {
+			return nil, err
+		}
+		return signature, nil
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
-	_, err := b.ClientCtx.Keyring.KeyByAddress(from)
+	_, err = b.ClientCtx.Keyring.KeyByAddress(from)
 	if err != nil {
 		b.Logger.Error("failed to find key in keyring", "address", address.String())
 		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
 	}
 
-	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
-	if err != nil {
-		return nil, err
-	}
-
 	// Sign the requested hash with the wallet
 	signature, _, err := b.ClientCtx.Keyring.SignByAddress(from, sigHash, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 	if err != nil {
@@ -162,3 +257,129 @@ func (b *Backend) SignTypedData(address common.Address, typedData apitypes.Typed
 	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
 	return signature, nil
 }
+
+// externalSigner forwards the signing requests to a Clef compatible external
+// signer, over HTTP or IPC. The signer is dialed on the first request so that
+// the node does not depend on the signer being up when it starts.
+type externalSigner struct {
+	endpoint string
+
+	mu     sync.Mutex
+	client *rpc.Client
+}
+
+func newExternalSigner(endpoint string) *externalSigner {
+	return &externalSigner{endpoint: endpoint}
+}
+
+func (s *externalSigner) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	s.mu.Lock()
+	if s.client == nil {
+		client, err := rpc.DialContext(ctx, s.endpoint)
+		if err != nil {
+			s.mu.Unlock()
+			return fmt.Errorf("failed to dial external signer: %w", err)
+		}
+		s.client = client
+	}
+	client := s.client
+	s.mu.Unlock()
+
+	return client.CallContext(ctx, result, method, args...)
+}
+
+// accounts returns the accounts managed by the external signer.
+func (s *externalSigner) accounts(ctx context.Context) ([]common.Address, error) {
+	var res []common.Address
+	if err := s.call(ctx, &res, "account_list"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// signTransaction requests the signature of tx by the from account.
+func (s *externalSigner) signTransaction(ctx context.Context, from common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	var to *common.MixedcaseAddress
+	if tx.To() != nil {
+		t := common.NewMixedcaseAddress(*tx.To())
+		to = &t
+	}
+	args := &apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(from),
+		To:      to,
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Input:   &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case ethtypes.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d for the external signer", tx.Type())
+	}
+	if tx.Type() != ethtypes.LegacyTxType {
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	}
+
+	var res rpctypes.SignTransactionResult
+	if err := s.call(ctx, &res, "account_signTransaction", args); err != nil {
+		return nil, err
+	}
+
+	signed := new(ethtypes.Transaction)
+	if err := signed.UnmarshalBinary(res.Raw); err != nil {
+		return nil, fmt.Errorf("invalid transaction from the external signer: %w", err)
+	}
+	return signed, nil
+}
+
+// signData requests the signature of the EIP-191 personal message data by the
+// address account.
+func (s *externalSigner) signData(ctx context.Context, address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	var signature hexutil.Bytes
+	signAddress := common.NewMixedcaseAddress(address)
+	if err := s.call(ctx, &signature, "account_signData", accounts.MimetypeTextPlain, &signAddress, data); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// signTypedData requests the signature of the EIP-712 typed data by the
+// address account.
+func (s *externalSigner) signTypedData(ctx context.Context, address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	var signature hexutil.Bytes
+	signAddress := common.NewMixedcaseAddress(address)
+	if err := s.call(ctx, &signature, "account_signTypedData", &signAddress, typedData); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// verifySignature returns an error if the [R || S || V] signature of hash was
+// not made by the address account. V is expected in its 27/28 form, as
+// returned by the signing methods.
+func verifySignature(address common.Address, hash []byte, signature hexutil.Bytes) error {
+	if len(signature) != crypto.SignatureLength {
+		return fmt.Errorf("invalid signature length from the external signer: %d", len(signature))
+	}
+
+	sig := bytes.Clone(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27 // Transform V from 27/28 to 0/1 to recover the public key
+	}
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return fmt.Errorf("invalid signature from the external signer: %w", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != address {
+		return fmt.Errorf("external signer signed with %s instead of the requested address %s", signer, address)
+	}
+	return nil
+}
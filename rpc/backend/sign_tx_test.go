@@ -0,0 +1,166 @@
+package backend
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// fakeClef implements the account_ methods of Clef used by the external
+// signer, signing every request with key.
+type fakeClef struct {
+	key     *ecdsa.PrivateKey
+	chainID *big.Int
+}
+
+func (c *fakeClef) List() []common.Address {
+	return []common.Address{crypto.PubkeyToAddress(c.key.PublicKey)}
+}
+
+func (c *fakeClef) SignData(_ string, _ common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return c.sign(accounts.TextHash(data))
+}
+
+func (c *fakeClef) SignTypedData(_ common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return c.sign(sigHash)
+}
+
+func (c *fakeClef) SignTransaction(args apitypes.SendTxArgs) (*rpctypes.SignTransactionResult, error) {
+	tx, err := args.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+	signed, err := ethtypes.SignTx(tx, ethtypes.LatestSignerForChainID(c.chainID), c.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.SignTransactionResult{Raw: raw, Tx: signed}, nil
+}
+
+func (c *fakeClef) sign(hash []byte) (hexutil.Bytes, error) {
+	sig, err := crypto.Sign(hash, c.key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+func setupExternalSigner(t *testing.T, key *ecdsa.PrivateKey) *Backend {
+	t.Helper()
+
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("account", &fakeClef{key: key, chainID: big.NewInt(9001)}))
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		httpSrv.Close()
+		srv.Stop()
+	})
+
+	return &Backend{externalSigner: newExternalSigner(httpSrv.URL)}
+}
+
+func TestExternalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "test", ChainId: (*math.HexOrDecimal256)(big.NewInt(9001))},
+		Message:     apitypes.TypedDataMessage{"contents": "hello"},
+	}
+
+	testCases := []struct {
+		name   string
+		key    *ecdsa.PrivateKey
+		expErr bool
+	}{
+		{"signed by the requested account", key, false},
+		{"signed by another account", otherKey, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := setupExternalSigner(t, tc.key)
+
+			sig, err := b.Sign(addr, hexutil.Bytes("hello"))
+			if tc.expErr {
+				require.ErrorContains(t, err, "instead of the requested address")
+			} else {
+				require.NoError(t, err)
+				require.Len(t, sig, crypto.SignatureLength)
+			}
+
+			sig, err = b.SignTypedData(addr, typedData)
+			if tc.expErr {
+				require.ErrorContains(t, err, "instead of the requested address")
+			} else {
+				require.NoError(t, err)
+				require.Len(t, sig, crypto.SignatureLength)
+			}
+
+			to := common.HexToAddress("0x1")
+			tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+				ChainID:   big.NewInt(9001),
+				Nonce:     1,
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(10),
+				Gas:       21000,
+				To:        &to,
+				Value:     big.NewInt(100),
+			})
+			signed, err := b.externalSigner.signTransaction(context.Background(), addr, tx, big.NewInt(9001))
+			require.NoError(t, err)
+			require.Equal(t, tx.Nonce(), signed.Nonce())
+			sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(big.NewInt(9001)), signed)
+			require.NoError(t, err)
+			require.Equal(t, crypto.PubkeyToAddress(tc.key.PublicKey), sender)
+		})
+	}
+}
+
+func TestExternalSignerAccounts(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	b := setupExternalSigner(t, key)
+	addrs, err := b.Accounts()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{crypto.PubkeyToAddress(key.PublicKey)}, addrs)
+}
+
+func TestExternalSignerUnreachable(t *testing.T) {
+	b := &Backend{externalSigner: newExternalSigner("/nonexistent/clef.ipc")}
+	_, err := b.Sign(common.HexToAddress("0x1"), hexutil.Bytes("hello"))
+	require.ErrorContains(t, err, "failed to dial external signer")
+}
@@ -37,6 +37,10 @@ func (b *Backend) GetTransactionByHash(ctx context.Context, txHash common.Hash)
 	ctx, span := tracer.Start(ctx, "GetTransactionByHash", trace.WithAttributes(attribute.String("txHash", txHash.Hex())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if tx, ok := b.cache.txs.get(txHash); ok {
+		return tx, nil
+	}
+
 	res, err := b.GetTxByEthHash(ctx, txHash)
 	if err != nil {
 		return b.GetTransactionByHashPending(ctx, txHash)
@@ -58,7 +62,7 @@ func (b *Backend) GetTransactionByHash(ctx context.Context, txHash common.Hash)
 		return nil, errors.New("invalid ethereum tx")
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &block.Block.Height)
+	blockRes, err := b.blockResults(ctx, &block.Block.Height)
 	if err != nil {
 		b.Logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
 		return nil, fmt.Errorf("block result not found: %w", err)
@@ -82,7 +86,7 @@ func (b *Backend) GetTransactionByHash(ctx context.Context, txHash common.Hash)
 	height := uint64(res.Height)                       //#nosec G115 -- checked for int overflow already
 	blockTime := uint64(block.Block.Time.UTC().Unix()) //#nosec G115 -- checked for int overflow already
 	index := uint64(res.EthTxIndex)                    //#nosec G115 -- checked for int overflow already
-	return rpctypes.NewTransactionFromMsg(
+	result = rpctypes.NewTransactionFromMsg(
 		msg,
 		common.BytesToHash(block.BlockID.Hash.Bytes()),
 		height,
@@ -90,7 +94,9 @@ func (b *Backend) GetTransactionByHash(ctx context.Context, txHash common.Hash)
 		index,
 		baseFee,
 		b.ChainConfig(),
-	), nil
+	)
+	b.cache.txs.add(txHash, result)
+	return result, nil
 }
 
 // GetTransactionByHashPending find pending tx from mempool
@@ -144,6 +150,10 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 	hexTx := hash.Hex()
 	b.Logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok := b.cache.getReceipt(hash); ok {
+		return receipt, nil
+	}
+
 	// Retry logic for transaction lookup with exponential backoff
 	maxRetries := 10
 	baseDelay := 50 * time.Millisecond
@@ -188,7 +198,7 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, fmt.Errorf("failed to decode tx: %w", err)
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &res.Height)
+	blockRes, err := b.blockResults(ctx, &res.Height)
 	if err != nil {
 		b.Logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
 		return nil, fmt.Errorf("block result not found at height %d: %w", res.Height, err)
@@ -212,7 +222,12 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, fmt.Errorf("failed to get sender: %w", err)
 	}
 
-	return rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	result, err = rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	if err != nil {
+		return nil, err
+	}
+	b.cache.addReceipt(hash, result)
+	return result, nil
 }
 
 // GetTransactionLogs returns the transaction logs identified by hash.
@@ -233,7 +248,7 @@ func (b *Backend) GetTransactionLogs(ctx context.Context, hash common.Hash) (res
 		return nil, nil
 	}
 
-	resBlockResult, err := b.RPCClient.BlockResults(ctx, &res.Height)
+	resBlockResult, err := b.blockResults(ctx, &res.Height)
 	if err != nil {
 		b.Logger.Debug("block result not found", "number", res.Height, "error", err.Error())
 		return nil, nil
@@ -351,6 +366,56 @@ func (b *Backend) GetTxByTxIndex(ctx context.Context, height int64, index uint)
 	return txResult, nil
 }
 
+// addressTxIndexer returns the EVM tx indexer if it supports the address indexes.
+func (b *Backend) addressTxIndexer() (servertypes.EVMAddressTxIndexer, error) {
+	idxer, ok := b.Indexer.(servertypes.EVMAddressTxIndexer)
+	if !ok {
+		return nil, errors.New("address indexes are not available, enable the EVM tx indexer")
+	}
+	return idxer, nil
+}
+
+// SearchTxsByAddress returns the hashes of the eth txs sent from, sent to or
+// creating the address, in the blocks after the given height, or before it when
+// reverse is set. It also returns whether more txs remain.
+func (b *Backend) SearchTxsByAddress(ctx context.Context, address common.Address, height int64, reverse bool, limit int) (hashes []common.Hash, more bool, err error) {
+	_, span := tracer.Start(ctx, "SearchTxsByAddress", trace.WithAttributes(attribute.String("address", address.Hex()), attribute.Int64("height", height)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, err := b.addressTxIndexer()
+	if err != nil {
+		return nil, false, err
+	}
+	return idxer.GetByAddress(address, height, reverse, limit)
+}
+
+// GetTxHashBySenderAndNonce returns the hash of the eth tx sent by the address
+// with the given nonce, or nil if not found.
+func (b *Backend) GetTxHashBySenderAndNonce(ctx context.Context, sender common.Address, nonce uint64) (result *common.Hash, err error) {
+	//nolint:gosec // G115
+	_, span := tracer.Start(ctx, "GetTxHashBySenderAndNonce", trace.WithAttributes(attribute.String("sender", sender.Hex()), attribute.Int64("nonce", int64(nonce))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, err := b.addressTxIndexer()
+	if err != nil {
+		return nil, err
+	}
+	return idxer.GetBySenderAndNonce(sender, nonce)
+}
+
+// GetContractCreationTxHash returns the hash of the eth tx which deployed the
+// contract, or nil if not found.
+func (b *Backend) GetContractCreationTxHash(ctx context.Context, contract common.Address) (result *common.Hash, err error) {
+	_, span := tracer.Start(ctx, "GetContractCreationTxHash", trace.WithAttributes(attribute.String("contract", contract.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, err := b.addressTxIndexer()
+	if err != nil {
+		return nil, err
+	}
+	return idxer.GetContractCreation(contract)
+}
+
 // QueryCometTxIndexer query tx in CometBFT tx indexer
 func (b *Backend) QueryCometTxIndexer(ctx context.Context, query string, txGetter func(*rpctypes.ParsedTxs) *rpctypes.ParsedTx) (result *servertypes.TxResult, err error) {
 	ctx, span := tracer.Start(ctx, "QueryCometTxIndexer")
@@ -386,7 +451,7 @@ func (b *Backend) GetTransactionByBlockAndIndex(ctx context.Context, block *cmtr
 	ctx, span := tracer.Start(ctx, "GetTransactionByBlockAndIndex", trace.WithAttributes(attribute.Int64("blockHeight", block.Block.Height), attribute.Int64("idx", int64(idx))))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &block.Block.Height)
+	blockRes, err := b.blockResults(ctx, &block.Block.Height)
 	if err != nil {
 		return nil, nil
 	}
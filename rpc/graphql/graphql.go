@@ -0,0 +1,1320 @@
+// Package graphql implements the EIP-1767 GraphQL interface over the EVM
+// backend of the JSON-RPC server.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethfilters "github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+var (
+	errBlockNotFound     = errors.New("block not found")
+	errInvalidBlockRange = errors.New("invalid from and to block combination: from > to")
+)
+
+// Backend is the backend the GraphQL queries are resolved with, it is
+// implemented by the JSON-RPC backend.
+type Backend interface {
+	backend.EVMBackend
+	filters.Backend
+}
+
+// Long is a 64 bit integer, accepted as a number or as a decimal or
+// hexadecimal string.
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*b = Long(value) //nolint:gosec // G115 // block numbers and gas won't exceed int64
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// blockNumberOrHash returns a BlockNumberOrHash selecting the block number.
+func blockNumberOrHash(number rpctypes.BlockNumber) rpctypes.BlockNumberOrHash {
+	return rpctypes.BlockNumberOrHash{BlockNumber: &number}
+}
+
+// Account is an account at a particular block.
+type Account struct {
+	r             *Resolver
+	address       common.Address
+	blockNrOrHash rpctypes.BlockNumberOrHash
+}
+
+func (a *Account) Address(_ context.Context) common.Address {
+	return a.address
+}
+
+func (a *Account) Balance(ctx context.Context) (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(ctx, a.address, a.blockNrOrHash)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *Account) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	blockNum, err := a.r.backend.BlockNumberFromComet(ctx, a.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := a.r.backend.GetTransactionCount(ctx, a.address, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code(ctx context.Context) (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(ctx, a.address, a.blockNrOrHash)
+}
+
+func (a *Account) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(ctx, a.address, args.Slot.Hex(), a.blockNrOrHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Log is an event log.
+type Log struct {
+	r           *Resolver
+	transaction *Transaction
+	log         *ethtypes.Log
+}
+
+func (l *Log) Transaction(_ context.Context) *Transaction {
+	return l.transaction
+}
+
+func (l *Log) Account(_ context.Context, args BlockNumberArgs) *Account {
+	return &Account{
+		r:             l.r,
+		address:       l.log.Address,
+		blockNrOrHash: args.NumberOrLatest(),
+	}
+}
+
+func (l *Log) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *Log) Topics(_ context.Context) []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data(_ context.Context) hexutil.Bytes {
+	return l.log.Data
+}
+
+// AccessTuple is an entry of an EIP-2930 access list.
+type AccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *AccessTuple) Address(_ context.Context) common.Address {
+	return at.address
+}
+
+func (at *AccessTuple) StorageKeys(_ context.Context) []common.Hash {
+	return at.storageKeys
+}
+
+// Withdrawal is an EIP-4895 withdrawal, Cosmos chains have none.
+type Withdrawal struct{}
+
+func (w *Withdrawal) Index(_ context.Context) hexutil.Uint64     { return 0 }
+func (w *Withdrawal) Validator(_ context.Context) hexutil.Uint64 { return 0 }
+func (w *Withdrawal) Address(_ context.Context) common.Address   { return common.Address{} }
+func (w *Withdrawal) Amount(_ context.Context) hexutil.Uint64    { return 0 }
+
+// Transaction is an Ethereum transaction. The hash is mandatory, the other
+// fields are fetched when required.
+type Transaction struct {
+	r    *Resolver
+	hash common.Hash
+
+	mu sync.Mutex
+	// mu protects the following fields
+	tx    *ethtypes.Transaction
+	block *Block
+	index uint64
+}
+
+// resolve returns the transaction, fetching it if needed, and the block it
+// was included in, which is nil for a pending transaction.
+func (t *Transaction) resolve(ctx context.Context) (*ethtypes.Transaction, *Block) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.tx != nil {
+		return t.tx, t.block
+	}
+
+	if res, err := t.r.backend.GetTxByEthHash(ctx, t.hash); err == nil {
+		block := &Block{r: t.r, numberOrHash: blockNumberOrHash(rpctypes.BlockNumber(res.Height))}
+		ethBlock, err := block.resolve(ctx)
+		if err != nil || ethBlock == nil {
+			return nil, nil
+		}
+		for i, tx := range ethBlock.Transactions() {
+			if tx.Hash() == t.hash {
+				t.tx, t.block, t.index = tx, block, uint64(i)
+				return t.tx, t.block
+			}
+		}
+		return nil, nil
+	}
+
+	// the transaction is not included yet, look it up in the mempool
+	txs, err := t.r.backend.PendingTransactions(ctx)
+	if err != nil {
+		return nil, nil
+	}
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if ok && ethMsg.Hash() == t.hash {
+				t.tx = ethMsg.AsTransaction()
+				return t.tx, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (t *Transaction) Hash(_ context.Context) common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) InputData(ctx context.Context) hexutil.Bytes {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return hexutil.Bytes{}
+	}
+	return tx.Data()
+}
+
+func (t *Transaction) Gas(ctx context.Context) hexutil.Uint64 {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return 0
+	}
+	return hexutil.Uint64(tx.Gas())
+}
+
+func (t *Transaction) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	tx, block := t.resolve(ctx)
+	if tx == nil {
+		return hexutil.Big{}, nil
+	}
+	if block == nil {
+		return hexutil.Big(*tx.GasPrice()), nil
+	}
+	ethBlock, err := block.resolve(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*rpctypes.EffectiveGasPrice(tx, ethBlock.BaseFee())), nil
+}
+
+func (t *Transaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	tx, block := t.resolve(ctx)
+	if tx == nil || block == nil {
+		return nil, nil
+	}
+	ethBlock, err := block.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ethBlock.BaseFee() == nil {
+		return (*hexutil.Big)(tx.GasPrice()), nil
+	}
+	return (*hexutil.Big)(rpctypes.EffectiveGasPrice(tx, ethBlock.BaseFee())), nil
+}
+
+func (t *Transaction) MaxFeePerGas(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtypes.SetCodeTxType:
+		return (*hexutil.Big)(tx.GasFeeCap())
+	default:
+		return nil
+	}
+}
+
+func (t *Transaction) MaxPriorityFeePerGas(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtypes.SetCodeTxType:
+		return (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil
+	}
+}
+
+func (t *Transaction) MaxFeePerBlobGas(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	return (*hexutil.Big)(tx.BlobGasFeeCap())
+}
+
+func (t *Transaction) BlobVersionedHashes(ctx context.Context) *[]common.Hash {
+	tx, _ := t.resolve(ctx)
+	if tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil
+	}
+	blobHashes := tx.BlobHashes()
+	return &blobHashes
+}
+
+func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
+	tx, block := t.resolve(ctx)
+	if tx == nil || block == nil {
+		return nil, nil
+	}
+	ethBlock, err := block.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ethBlock.BaseFee() == nil {
+		return (*hexutil.Big)(tx.GasPrice()), nil
+	}
+	tip, err := tx.EffectiveGasTip(ethBlock.BaseFee())
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *Transaction) Value(ctx context.Context) (hexutil.Big, error) {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return hexutil.Big{}, nil
+	}
+	if tx.Value() == nil {
+		return hexutil.Big{}, fmt.Errorf("invalid transaction value %x", t.hash)
+	}
+	return hexutil.Big(*tx.Value()), nil
+}
+
+func (t *Transaction) Nonce(ctx context.Context) hexutil.Uint64 {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return 0
+	}
+	return hexutil.Uint64(tx.Nonce())
+}
+
+func (t *Transaction) To(ctx context.Context, args BlockNumberArgs) *Account {
+	tx, _ := t.resolve(ctx)
+	if tx == nil || tx.To() == nil {
+		return nil
+	}
+	return &Account{
+		r:             t.r,
+		address:       *tx.To(),
+		blockNrOrHash: args.NumberOrLatest(),
+	}
+}
+
+func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) *Account {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	from, _ := ethtypes.Sender(ethtypes.LatestSigner(t.r.backend.ChainConfig()), tx)
+	return &Account{
+		r:             t.r,
+		address:       from,
+		blockNrOrHash: args.NumberOrLatest(),
+	}
+}
+
+func (t *Transaction) Block(ctx context.Context) *Block {
+	_, block := t.resolve(ctx)
+	return block
+}
+
+func (t *Transaction) Index(ctx context.Context) *hexutil.Uint64 {
+	_, block := t.resolve(ctx)
+	if block == nil {
+		return nil
+	}
+	index := hexutil.Uint64(t.index)
+	return &index
+}
+
+// getReceipt returns the receipt of the transaction, which is nil for a
+// pending transaction.
+func (t *Transaction) getReceipt(ctx context.Context) (*ethtypes.Receipt, error) {
+	_, block := t.resolve(ctx)
+	if block == nil {
+		return nil, nil
+	}
+	receipts, err := block.resolveReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if t.index >= uint64(len(receipts)) {
+		return nil, fmt.Errorf("receipt of transaction %s not found", t.hash)
+	}
+	return receipts[t.index], nil
+}
+
+func (t *Transaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(receipt.Status)
+	return &status, nil
+}
+
+func (t *Transaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := hexutil.Uint64(receipt.GasUsed)
+	return &gasUsed, nil
+}
+
+func (t *Transaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := hexutil.Uint64(receipt.CumulativeGasUsed)
+	return &gasUsed, nil
+}
+
+func (t *Transaction) BlobGasUsed(_ context.Context) *hexutil.Uint64 {
+	return nil
+}
+
+func (t *Transaction) BlobGasPrice(_ context.Context) *hexutil.Big {
+	return nil
+}
+
+func (t *Transaction) CreatedContract(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil || receipt.ContractAddress == (common.Address{}) {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       receipt.ContractAddress,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	logs := make([]*Log, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = &Log{r: t.r, transaction: t, log: log}
+	}
+	return &logs, nil
+}
+
+func (t *Transaction) Type(ctx context.Context) *hexutil.Uint64 {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	txType := hexutil.Uint64(tx.Type())
+	return &txType
+}
+
+func (t *Transaction) AccessList(ctx context.Context) *[]*AccessTuple {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return nil
+	}
+	accessList := tx.AccessList()
+	tuples := make([]*AccessTuple, len(accessList))
+	for i, tuple := range accessList {
+		tuples[i] = &AccessTuple{address: tuple.Address, storageKeys: tuple.StorageKeys}
+	}
+	return &tuples
+}
+
+func (t *Transaction) R(ctx context.Context) hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return hexutil.Big{}
+	}
+	_, r, _ := tx.RawSignatureValues()
+	return hexutil.Big(*r)
+}
+
+func (t *Transaction) S(ctx context.Context) hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return hexutil.Big{}
+	}
+	_, _, s := tx.RawSignatureValues()
+	return hexutil.Big(*s)
+}
+
+func (t *Transaction) V(ctx context.Context) hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return hexutil.Big{}
+	}
+	v, _, _ := tx.RawSignatureValues()
+	return hexutil.Big(*v)
+}
+
+func (t *Transaction) YParity(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolve(ctx)
+	if tx == nil || tx.Type() == ethtypes.LegacyTxType {
+		return nil
+	}
+	v, _, _ := tx.RawSignatureValues()
+	yParity := hexutil.Big(*v)
+	return &yParity
+}
+
+func (t *Transaction) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	tx, _ := t.resolve(ctx)
+	if tx == nil {
+		return hexutil.Bytes{}, nil
+	}
+	return tx.MarshalBinary()
+}
+
+func (t *Transaction) RawReceipt(ctx context.Context) (hexutil.Bytes, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return hexutil.Bytes{}, err
+	}
+	return receipt.MarshalBinary()
+}
+
+// Block is an Ethereum block, built from the CometBFT block. The number or
+// hash is mandatory, the other fields are fetched when required.
+type Block struct {
+	r            *Resolver
+	numberOrHash rpctypes.BlockNumberOrHash
+
+	mu sync.Mutex
+	// mu protects the following fields
+	hash     common.Hash
+	block    *ethtypes.Block
+	receipts []*ethtypes.Receipt
+}
+
+// resolve returns the block, fetching it if needed. It returns nil if the
+// block doesn't exist.
+func (b *Block) resolve(ctx context.Context) (*ethtypes.Block, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.block != nil {
+		return b.block, nil
+	}
+
+	blockNum, err := b.r.backend.BlockNumberFromComet(ctx, b.numberOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := b.r.backend.CometBlockByNumber(ctx, blockNum)
+	if err != nil || resBlock == nil {
+		return nil, err
+	}
+	block, err := b.r.backend.EthBlockByNumber(ctx, rpctypes.BlockNumber(resBlock.Block.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	// the block hash is the CometBFT block hash, as returned by the JSON-RPC server
+	b.hash = common.BytesToHash(resBlock.BlockID.Hash)
+	b.block = block
+	return b.block, nil
+}
+
+// resolveHeader returns the header of the block, or an error if the block
+// doesn't exist.
+func (b *Block) resolveHeader(ctx context.Context) (*ethtypes.Header, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errBlockNotFound
+	}
+	return block.Header(), nil
+}
+
+// resolveReceipts returns the receipts of the block, fetching them if needed.
+func (b *Block) resolveReceipts(ctx context.Context) ([]*ethtypes.Receipt, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.receipts != nil {
+		return b.receipts, nil
+	}
+
+	rpcReceipts, err := b.r.backend.GetBlockReceipts(ctx, blockNumberOrHash(rpctypes.BlockNumber(header.Number.Int64())))
+	if err != nil {
+		return nil, err
+	}
+	receipts := make([]*ethtypes.Receipt, len(rpcReceipts))
+	for i, rpcReceipt := range rpcReceipts {
+		if receipts[i], err = decodeReceipt(rpcReceipt); err != nil {
+			return nil, err
+		}
+	}
+	b.receipts = receipts
+	return receipts, nil
+}
+
+// decodeReceipt decodes a receipt in the JSON-RPC format.
+func decodeReceipt(rpcReceipt map[string]interface{}) (*ethtypes.Receipt, error) {
+	bz, err := json.Marshal(rpcReceipt)
+	if err != nil {
+		return nil, err
+	}
+	var receipt ethtypes.Receipt
+	if err := json.Unmarshal(bz, &receipt); err != nil {
+		return nil, fmt.Errorf("failed to decode receipt: %w", err)
+	}
+	return &receipt, nil
+}
+
+func (b *Block) Number(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.Number.Uint64()), nil
+}
+
+func (b *Block) Hash(ctx context.Context) (common.Hash, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return common.Hash{}, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.hash, nil
+}
+
+func (b *Block) GasLimit(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasLimit), nil
+}
+
+func (b *Block) GasUsed(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasUsed), nil
+}
+
+func (b *Block) BaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(header.BaseFee), nil
+}
+
+// NextBaseFeePerGas returns the base fee of the next block, it is null until
+// the next block is committed as the fee market module sets it at the start of
+// the block.
+func (b *Block) NextBaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	next := &Block{r: b.r, numberOrHash: blockNumberOrHash(rpctypes.BlockNumber(header.Number.Int64() + 1))}
+	nextBlock, err := next.resolve(ctx)
+	if err != nil || nextBlock == nil {
+		return nil, nil //nolint:nilerr // the next block is not committed yet
+	}
+	return (*hexutil.Big)(nextBlock.BaseFee()), nil
+}
+
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if header.Number.Int64() <= 1 {
+		return nil, nil
+	}
+	return &Block{
+		r:            b.r,
+		numberOrHash: blockNumberOrHash(rpctypes.BlockNumber(header.Number.Int64() - 1)),
+	}, nil
+}
+
+func (b *Block) Difficulty(ctx context.Context) (hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*header.Difficulty), nil
+}
+
+func (b *Block) Timestamp(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.Time), nil
+}
+
+func (b *Block) Nonce(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Nonce[:], nil
+}
+
+func (b *Block) MixHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.MixDigest, nil
+}
+
+func (b *Block) TransactionsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.TxHash, nil
+}
+
+func (b *Block) StateRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Root, nil
+}
+
+func (b *Block) ReceiptsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.ReceiptHash, nil
+}
+
+func (b *Block) OmmerHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.UncleHash, nil
+}
+
+func (b *Block) OmmerCount(ctx context.Context) (*hexutil.Uint64, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(0)
+	return &count, nil
+}
+
+func (b *Block) Ommers(ctx context.Context) (*[]*Block, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	return &[]*Block{}, nil
+}
+
+func (b *Block) OmmerAt(_ context.Context, _ struct{ Index Long }) *Block {
+	return nil
+}
+
+func (b *Block) ExtraData(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Extra, nil
+}
+
+func (b *Block) LogsBloom(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Bloom.Bytes(), nil
+}
+
+func (b *Block) RawHeader(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(header)
+}
+
+func (b *Block) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(b.block)
+}
+
+// BlockNumberArgs encapsulates arguments to accessors that specify a block number.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// NumberOrLatest returns the provided block number argument, or the latest
+// block number if none was provided.
+func (a BlockNumberArgs) NumberOrLatest() rpctypes.BlockNumberOrHash {
+	if a.Block != nil {
+		return blockNumberOrHash(rpctypes.BlockNumber(*a.Block))
+	}
+	return blockNumberOrHash(rpctypes.EthLatestBlockNumber)
+}
+
+func (b *Block) Miner(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       header.Coinbase,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (b *Block) TransactionCount(ctx context.Context) (*hexutil.Uint64, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(b.block.Transactions()))
+	return &count, nil
+}
+
+func (b *Block) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	txs := make([]*Transaction, len(b.block.Transactions()))
+	for i, tx := range b.block.Transactions() {
+		txs[i] = &Transaction{
+			r:     b.r,
+			hash:  tx.Hash(),
+			tx:    tx,
+			block: b,
+			index: uint64(i),
+		}
+	}
+	return &txs, nil
+}
+
+func (b *Block) TransactionAt(ctx context.Context, args struct{ Index Long }) (*Transaction, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	txs := b.block.Transactions()
+	if args.Index < 0 || int(args.Index) >= len(txs) {
+		return nil, nil
+	}
+	tx := txs[args.Index]
+	return &Transaction{
+		r:     b.r,
+		hash:  tx.Hash(),
+		tx:    tx,
+		block: b,
+		index: uint64(args.Index),
+	}, nil
+}
+
+func (b *Block) WithdrawalsRoot(ctx context.Context) (*common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return header.WithdrawalsHash, nil
+}
+
+func (b *Block) Withdrawals(ctx context.Context) (*[]*Withdrawal, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (b *Block) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.BlobGasUsed == nil {
+		return nil, err
+	}
+	blobGasUsed := hexutil.Uint64(*header.BlobGasUsed)
+	return &blobGasUsed, nil
+}
+
+func (b *Block) ExcessBlobGas(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.ExcessBlobGas == nil {
+		return nil, err
+	}
+	excessBlobGas := hexutil.Uint64(*header.ExcessBlobGas)
+	return &excessBlobGas, nil
+}
+
+// BlockFilterCriteria encapsulates the criteria of the logs accessor of a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+	Topics    *[][]common.Hash  // restricts matches to particular event topics
+}
+
+// runFilter runs the filter and returns its results as Log objects.
+func runFilter(ctx context.Context, r *Resolver, filter *filters.Filter) ([]*Log, error) {
+	logs, err := filter.Logs(ctx, int(r.backend.RPCLogsCap()), int64(r.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Log, len(logs))
+	for i, log := range logs {
+		ret[i] = &Log{
+			r:           r,
+			transaction: &Transaction{r: r, hash: log.TxHash},
+			log:         log,
+		}
+	}
+	return ret, nil
+}
+
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	criteria := ethfilters.FilterCriteria{BlockHash: &hash}
+	if args.Filter.Addresses != nil {
+		criteria.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		criteria.Topics = *args.Filter.Topics
+	}
+	return runFilter(ctx, b.r, filters.NewBlockFilter(b.r.logger, b.r.backend, criteria))
+}
+
+// numberOrHashResolved returns the number of the block, so the queries at the
+// block state don't resolve the hash again.
+func (b *Block) numberOrHashResolved(ctx context.Context) (rpctypes.BlockNumberOrHash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return rpctypes.BlockNumberOrHash{}, err
+	}
+	return blockNumberOrHash(rpctypes.BlockNumber(header.Number.Int64())), nil
+}
+
+func (b *Block) Account(ctx context.Context, args struct{ Address common.Address }) (*Account, error) {
+	numberOrHash, err := b.numberOrHashResolved(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       args.Address,
+		blockNrOrHash: numberOrHash,
+	}, nil
+}
+
+// CallData encapsulates the arguments of the call and estimateGas accessors.
+// All arguments are optional.
+type CallData struct {
+	From                 *common.Address // The Ethereum address the call is from.
+	To                   *common.Address // The Ethereum address the call is to.
+	Gas                  *Long           // The amount of gas provided for the call.
+	GasPrice             *hexutil.Big    // The price of each unit of gas, in wei.
+	MaxFeePerGas         *hexutil.Big    // The max price of each unit of gas, in wei (1559).
+	MaxPriorityFeePerGas *hexutil.Big    // The max tip of each unit of gas, in wei (1559).
+	Value                *hexutil.Big    // The value sent along with the call.
+	Data                 *hexutil.Bytes  // Any data sent with the call.
+}
+
+// toTransactionArgs converts the call data to the backend transaction arguments.
+func (c CallData) toTransactionArgs() evmtypes.TransactionArgs {
+	args := evmtypes.TransactionArgs{
+		From:                 c.From,
+		To:                   c.To,
+		GasPrice:             c.GasPrice,
+		MaxFeePerGas:         c.MaxFeePerGas,
+		MaxPriorityFeePerGas: c.MaxPriorityFeePerGas,
+		Value:                c.Value,
+		Data:                 c.Data,
+	}
+	if c.Gas != nil {
+		gas := hexutil.Uint64(*c.Gas) //nolint:gosec // G115 // negative gas is rejected by the call
+		args.Gas = &gas
+	}
+	return args
+}
+
+// CallResult is the result of a call.
+type CallResult struct {
+	data    hexutil.Bytes  // The return data from the call
+	gasUsed hexutil.Uint64 // The amount of gas used
+	status  hexutil.Uint64 // The return status of the call - 0 for failure or 1 for success.
+}
+
+func (c *CallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *CallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *CallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+// doCall executes the call at the block state.
+func doCall(ctx context.Context, r *Resolver, data CallData, blockNum rpctypes.BlockNumber) (*CallResult, error) {
+	res, err := r.backend.DoCall(ctx, data.toTransactionArgs(), blockNum, nil)
+	if err != nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(1)
+	if res.Failed() {
+		status = 0
+	}
+	return &CallResult{
+		data:    res.Ret,
+		gasUsed: hexutil.Uint64(res.GasUsed),
+		status:  status,
+	}, nil
+}
+
+func (b *Block) Call(ctx context.Context, args struct{ Data CallData }) (*CallResult, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return doCall(ctx, b.r, args.Data, rpctypes.BlockNumber(header.Number.Int64()))
+}
+
+func (b *Block) EstimateGas(ctx context.Context, args struct{ Data CallData }) (hexutil.Uint64, error) {
+	numberOrHash, err := b.numberOrHashResolved(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return b.r.backend.EstimateGas(ctx, args.Data.toTransactionArgs(), &numberOrHash, nil)
+}
+
+// Pending is the pending state, made of the mempool transactions.
+type Pending struct {
+	r *Resolver
+}
+
+// pendingTransactions returns the Ethereum transactions of the mempool.
+func (p *Pending) pendingTransactions(ctx context.Context) ([]*ethtypes.Transaction, error) {
+	txs, err := p.r.backend.PendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ethTxs []*ethtypes.Transaction
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				ethTxs = append(ethTxs, ethMsg.AsTransaction())
+			}
+		}
+	}
+	return ethTxs, nil
+}
+
+func (p *Pending) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	txs, err := p.pendingTransactions(ctx)
+	return hexutil.Uint64(len(txs)), err
+}
+
+func (p *Pending) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	txs, err := p.pendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, len(txs))
+	for i, tx := range txs {
+		ret[i] = &Transaction{
+			r:     p.r,
+			hash:  tx.Hash(),
+			tx:    tx,
+			index: uint64(i),
+		}
+	}
+	return &ret, nil
+}
+
+func (p *Pending) Account(_ context.Context, args struct{ Address common.Address }) *Account {
+	return &Account{
+		r:             p.r,
+		address:       args.Address,
+		blockNrOrHash: blockNumberOrHash(rpctypes.EthPendingBlockNumber),
+	}
+}
+
+func (p *Pending) Call(ctx context.Context, args struct{ Data CallData }) (*CallResult, error) {
+	return doCall(ctx, p.r, args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) EstimateGas(ctx context.Context, args struct{ Data CallData }) (hexutil.Uint64, error) {
+	numberOrHash := blockNumberOrHash(rpctypes.EthPendingBlockNumber)
+	return p.r.backend.EstimateGas(ctx, args.Data.toTransactionArgs(), &numberOrHash, nil)
+}
+
+// Resolver is the top-level object in the GraphQL hierarchy.
+type Resolver struct {
+	backend Backend
+	logger  log.Logger
+}
+
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+}) (*Block, error) {
+	if args.Number != nil && args.Hash != nil {
+		return nil, errors.New("only one of number or hash must be specified")
+	}
+	var numberOrHash rpctypes.BlockNumberOrHash
+	switch {
+	case args.Number != nil:
+		if *args.Number < 0 {
+			return nil, nil
+		}
+		numberOrHash = blockNumberOrHash(rpctypes.BlockNumber(*args.Number))
+	case args.Hash != nil:
+		numberOrHash = rpctypes.BlockNumberOrHash{BlockHash: args.Hash}
+	default:
+		numberOrHash = blockNumberOrHash(rpctypes.EthLatestBlockNumber)
+	}
+
+	block := &Block{r: r, numberOrHash: numberOrHash}
+	ethBlock, err := block.resolve(ctx)
+	if err != nil || ethBlock == nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From *Long
+	To   *Long
+}) ([]*Block, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	from := int64(*args.From)
+
+	var to int64
+	if args.To != nil {
+		to = int64(*args.To)
+	} else {
+		latest, err := r.backend.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		to = int64(latest) //nolint:gosec // G115 // block number won't exceed int64
+	}
+	if to < from {
+		return nil, errInvalidBlockRange
+	}
+	if blockRangeCap := int64(r.backend.RPCBlockRangeCap()); to-from+1 > blockRangeCap {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockRangeCap)
+	}
+
+	var blocks []*Block
+	for i := from; i <= to; i++ {
+		block := &Block{r: r, numberOrHash: blockNumberOrHash(rpctypes.BlockNumber(i))}
+		ethBlock, err := block.resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ethBlock == nil {
+			// the next blocks don't exist either
+			break
+		}
+		blocks = append(blocks, block)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+func (r *Resolver) Pending(_ context.Context) *Pending {
+	return &Pending{r}
+}
+
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) *Transaction {
+	tx := &Transaction{r: r, hash: args.Hash}
+	if t, _ := tx.resolve(ctx); t == nil {
+		return nil
+	}
+	return tx
+}
+
+func (r *Resolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.backend.SendRawTransaction(ctx, args.Data)
+}
+
+// FilterCriteria encapsulates the arguments of the logs query.
+type FilterCriteria struct {
+	FromBlock *Long             // beginning of the queried range, nil means latest block
+	ToBlock   *Long             // end of the range, nil means latest block
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+	Topics    *[][]common.Hash  // restricts matches to particular event topics
+}
+
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	begin := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.FromBlock != nil {
+		begin = int64(*args.Filter.FromBlock)
+	}
+	end := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.ToBlock != nil {
+		end = int64(*args.Filter.ToBlock)
+	}
+	if begin > 0 && end > 0 && begin > end {
+		return nil, errInvalidBlockRange
+	}
+	var addresses []common.Address
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	var topics [][]common.Hash
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+	return runFilter(ctx, r, filters.NewRangeFilter(r.logger, r.backend, begin, end, addresses, topics))
+}
+
+func (r *Resolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	gasPrice, err := r.backend.GasPrice(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *gasPrice, nil
+}
+
+func (r *Resolver) MaxPriorityFeePerGas(ctx context.Context) (hexutil.Big, error) {
+	head, err := r.backend.CurrentHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	tipCap, err := r.backend.SuggestGasTipCap(ctx, head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tipCap), nil
+}
+
+func (r *Resolver) ChainID(ctx context.Context) (hexutil.Big, error) {
+	chainID, err := r.backend.ChainID(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *chainID, nil
+}
+
+// SyncState is the synchronisation status returned by the syncing query.
+type SyncState struct {
+	startingBlock hexutil.Uint64
+	currentBlock  hexutil.Uint64
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 {
+	return s.startingBlock
+}
+
+func (s *SyncState) CurrentBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// HighestBlock returns the current block, CometBFT doesn't report the height
+// of the peers.
+func (s *SyncState) HighestBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// Syncing returns null if the node is not catching up with the network.
+func (r *Resolver) Syncing(ctx context.Context) (*SyncState, error) {
+	res, err := r.backend.Syncing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	progress, ok := res.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	state := &SyncState{}
+	state.startingBlock, _ = progress["startingBlock"].(hexutil.Uint64)
+	state.currentBlock, _ = progress["currentBlock"].(hexutil.Uint64)
+	return state, nil
+}
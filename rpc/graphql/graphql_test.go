@@ -0,0 +1,156 @@
+package graphql
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+// testBackend serves a single block, the other backend methods are not
+// implemented.
+type testBackend struct {
+	Backend
+
+	block *ethtypes.Block
+	hash  common.Hash
+}
+
+func (b *testBackend) ChainID(_ context.Context) (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(9001)), nil
+}
+
+func (b *testBackend) GasPrice(_ context.Context) (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(1000)), nil
+}
+
+func (b *testBackend) BlockNumberFromComet(_ context.Context, blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error) {
+	if blockNrOrHash.BlockNumber == nil || *blockNrOrHash.BlockNumber < 0 {
+		return rpctypes.BlockNumber(b.block.NumberU64()), nil //nolint:gosec // G115 // test block number
+	}
+	return *blockNrOrHash.BlockNumber, nil
+}
+
+func (b *testBackend) CometBlockByNumber(_ context.Context, blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	if blockNum.Int64() != b.block.Number().Int64() {
+		return nil, nil
+	}
+	return &tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: b.hash.Bytes()},
+		Block:   &tmtypes.Block{Header: tmtypes.Header{Height: blockNum.Int64()}},
+	}, nil
+}
+
+func (b *testBackend) EthBlockByNumber(_ context.Context, _ rpctypes.BlockNumber) (*ethtypes.Block, error) {
+	return b.block, nil
+}
+
+func newTestHandler(t *testing.T) http.Handler {
+	t.Helper()
+	backend := &testBackend{
+		block: ethtypes.NewBlockWithHeader(&ethtypes.Header{
+			Number:     big.NewInt(10),
+			Difficulty: big.NewInt(0),
+			GasLimit:   30_000_000,
+			GasUsed:    21_000,
+			BaseFee:    big.NewInt(100),
+			Time:       1700000000,
+		}),
+		hash: common.HexToHash("0xabcd"),
+	}
+	handler, err := NewHandler(log.NewNopLogger(), backend, 1024)
+	require.NoError(t, err)
+	return handler
+}
+
+func TestGraphQLQueries(t *testing.T) {
+	handler := newTestHandler(t)
+
+	testCases := []struct {
+		name     string
+		body     string
+		code     int
+		expected string
+	}{
+		{
+			"chain id and gas price",
+			`{"query": "{chainID gasPrice}"}`,
+			http.StatusOK,
+			`{"data":{"chainID":"0x2329","gasPrice":"0x3e8"}}`,
+		},
+		{
+			"block by number",
+			`{"query": "{block(number: 10) {number hash gasLimit gasUsed baseFeePerGas timestamp ommerCount}}"}`,
+			http.StatusOK,
+			`{"data":{"block":{"number":"0xa","hash":"0x000000000000000000000000000000000000000000000000000000000000abcd","gasLimit":"0x1c9c380","gasUsed":"0x5208","baseFeePerGas":"0x64","timestamp":"0x6553f100","ommerCount":"0x0"}}}`,
+		},
+		{
+			"latest block with variables",
+			`{"query": "query($n: Long) {block(number: $n) {number}}", "variables": {"n": "0xa"}}`,
+			http.StatusOK,
+			`{"data":{"block":{"number":"0xa"}}}`,
+		},
+		{
+			"unknown block",
+			`{"query": "{block(number: 11) {number}}"}`,
+			http.StatusOK,
+			`{"data":{"block":null}}`,
+		},
+		{
+			"number and hash",
+			`{"query": "{block(number: 10, hash: \"0x000000000000000000000000000000000000000000000000000000000000abcd\") {number}}"}`,
+			http.StatusBadRequest,
+			`{"errors":[{"message":"only one of number or hash must be specified","path":["block"]}],"data":{"block":null}}`,
+		},
+		{
+			"invalid block range",
+			`{"query": "{blocks(from: 10, to: 9) {number}}"}`,
+			http.StatusBadRequest,
+			`{"errors":[{"message":"invalid from and to block combination: from > to","path":["blocks"]}],"data":null}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.code, rec.Code)
+			require.JSONEq(t, tc.expected, rec.Body.String())
+		})
+	}
+}
+
+func TestGraphQLInvalidRequest(t *testing.T) {
+	handler := newTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader("not json"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGraphQLOversizedRequest(t *testing.T) {
+	handler := newTestHandler(t)
+
+	query := `{"query": "{chainID}", "variables": {"pad": "` + strings.Repeat("a", 1024) + `"}}`
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(query))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
@@ -0,0 +1,62 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"cosmossdk.io/log/v2"
+)
+
+// maxQueryDepth is the maximum depth of the accepted queries, it bounds the
+// nested block and transaction lookups of a single query.
+const maxQueryDepth = 20
+
+// handler serves the GraphQL queries over HTTP.
+type handler struct {
+	schema    *graphql.Schema
+	bodyLimit int64
+}
+
+// NewHandler returns the HTTP handler of the GraphQL queries, resolved with the
+// provided backend. Requests larger than bodyLimit are rejected.
+func NewHandler(logger log.Logger, backend Backend, bodyLimit int) (http.Handler, error) {
+	resolver := &Resolver{backend: backend, logger: logger}
+	parsed, err := graphql.ParseSchema(schema, resolver, graphql.MaxDepth(maxQueryDepth))
+	if err != nil {
+		return nil, err
+	}
+	return &handler{schema: parsed, bodyLimit: int64(bodyLimit)}, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.bodyLimit)).Decode(&params); err != nil {
+		code := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			code = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, err.Error(), code)
+		return
+	}
+
+	response := h.schema.Exec(r.Context(), params.Query, params.OperationName, params.Variables)
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if len(response.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	_, _ = w.Write(responseJSON)
+}
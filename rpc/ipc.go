@@ -0,0 +1,297 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/stream"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+type IPCServer interface {
+	Start() error
+	Stop()
+}
+
+type ipcServer struct {
+	path      string      // path of the unix domain socket
+	fileMode  os.FileMode // permissions of the socket file
+	rpcServer *rpc.Server // serves the calls other than the subscriptions
+	api       *pubSubAPI
+	logger    log.Logger
+
+	mu       sync.Mutex
+	listener net.Listener
+}
+
+// NewIPCServer creates the IPC server listening on the unix domain socket at
+// path. The calls are served by rpcServer, which holds the same APIs as the
+// HTTP server, while the subscriptions are handled as on the websocket server.
+func NewIPCServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	backend rpcfilters.Backend,
+	rpcServer *rpc.Server,
+	path string,
+	fileMode os.FileMode,
+) IPCServer {
+	logger = logger.With("api", "ipc-server")
+	return &ipcServer{
+		path:      path,
+		fileMode:  fileMode,
+		rpcServer: rpcServer,
+		api:       newPubSubAPI(clientCtx, logger, stream, backend),
+		logger:    logger,
+	}
+}
+
+// Start listens on the unix domain socket, replacing any leftover socket file,
+// and serves the connections in the background.
+func (s *ipcServer) Start() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o750); err != nil {
+		return err
+	}
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove leftover ipc socket %s: %w", s.path, err)
+	}
+
+	ln, err := net.Listen("unix", s.path)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(s.path, s.fileMode); err != nil {
+		_ = ln.Close()
+		return err
+	}
+
+	s.mu.Lock()
+	s.listener = ln
+	s.mu.Unlock()
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					s.logger.Error("failed to accept IPC connection", "error", err.Error())
+				}
+				return
+			}
+			go s.serveConn(conn)
+		}
+	}()
+	return nil
+}
+
+// Stop closes the listener, which removes the socket file.
+func (s *ipcServer) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener == nil {
+		return
+	}
+	if err := s.listener.Close(); err != nil {
+		s.logger.Error("failed to close IPC listener", "error", err.Error())
+	}
+	s.listener = nil
+}
+
+type ipcConn struct {
+	conn net.Conn
+	enc  *json.Encoder
+	mux  *sync.Mutex
+}
+
+func newIPCConn(conn net.Conn) *ipcConn {
+	return &ipcConn{
+		conn: conn,
+		enc:  json.NewEncoder(conn),
+		mux:  new(sync.Mutex),
+	}
+}
+
+func (c *ipcConn) WriteJSON(v any) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.enc.Encode(v)
+}
+
+func (c *ipcConn) Close() error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.conn.Close()
+}
+
+func (s *ipcServer) sendErrResponse(conn *ipcConn, msg string) {
+	res := &ErrorResponseJSON{
+		Jsonrpc: "2.0",
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(-32600),
+			Message: msg,
+		},
+		ID: nil,
+	}
+
+	_ = conn.WriteJSON(res) // #nosec G703
+}
+
+// serveConn reads the messages of the connection until it is closed. The
+// subscriptions are handled by the pubsub API, the other messages are
+// forwarded to the RPC server through an in-memory pipe.
+func (s *ipcServer) serveConn(netConn net.Conn) {
+	conn := newIPCConn(netConn)
+
+	serverEnd, clientEnd := net.Pipe()
+	go s.rpcServer.ServeCodec(rpc.NewCodec(serverEnd), 0)
+	go s.writeResponses(conn, clientEnd)
+
+	// subscriptions of current connection
+	subscriptions := make(map[rpc.ID]context.CancelFunc)
+	defer func() {
+		// cancel all subscriptions when connection closed
+		for _, unsubFn := range subscriptions {
+			unsubFn()
+		}
+		_ = clientEnd.Close()
+		_ = conn.Close()
+	}()
+
+	dec := json.NewDecoder(netConn)
+	for {
+		var mb json.RawMessage
+		if err := dec.Decode(&mb); err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				s.logger.Debug("read message error, closing IPC connection", "error", err.Error())
+				s.sendErrResponse(conn, err.Error())
+			}
+			return
+		}
+
+		handled, err := s.handleSubscription(conn, subscriptions, mb)
+		if err != nil {
+			s.logger.Error("error writing subscription response", "error", err.Error())
+			return
+		}
+		if handled {
+			continue
+		}
+
+		if _, err := clientEnd.Write(mb); err != nil {
+			s.logger.Error("failed to forward IPC request", "error", err.Error())
+			return
+		}
+	}
+}
+
+// handleSubscription serves the eth_subscribe and eth_unsubscribe calls, it
+// returns false if the message is another call. The returned error is only
+// set if the response cannot be written.
+func (s *ipcServer) handleSubscription(conn *ipcConn, subscriptions map[rpc.ID]context.CancelFunc, mb []byte) (bool, error) {
+	if isBatch(mb) {
+		return false, nil
+	}
+
+	var msg map[string]any
+	if err := json.Unmarshal(mb, &msg); err != nil {
+		return false, nil
+	}
+
+	method, ok := msg["method"].(string)
+	if !ok || (method != "eth_subscribe" && method != "eth_unsubscribe") {
+		return false, nil
+	}
+
+	var connID float64
+	var err error
+	switch id := msg["id"].(type) {
+	case string:
+		connID, err = strconv.ParseFloat(id, 64)
+	case float64:
+		connID = id
+	default:
+		err = fmt.Errorf("unknown type")
+	}
+	if err != nil {
+		s.sendErrResponse(conn, fmt.Errorf("invalid type for connection ID: %T", msg["id"]).Error())
+		return true, nil
+	}
+
+	params, ok := msg["params"].([]any)
+	if !ok || len(params) == 0 {
+		s.sendErrResponse(conn, "invalid parameters")
+		return true, nil
+	}
+
+	res := &SubscriptionResponseJSON{
+		Jsonrpc: "2.0",
+		ID:      connID,
+	}
+
+	ready := make(chan struct{})
+	switch method {
+	case "eth_subscribe":
+		subID := rpc.NewID()
+		unsubFn, err := s.api.subscribe(conn, subID, params, ready)
+		if err != nil {
+			s.sendErrResponse(conn, err.Error())
+			return true, nil
+		}
+		subscriptions[subID] = unsubFn
+		res.Result = subID
+	case "eth_unsubscribe":
+		id, ok := params[0].(string)
+		if !ok {
+			s.sendErrResponse(conn, "invalid parameters")
+			return true, nil
+		}
+
+		subID := rpc.ID(id)
+		unsubFn, ok := subscriptions[subID]
+		if ok {
+			delete(subscriptions, subID)
+			unsubFn()
+		}
+		res.Result = ok
+	}
+
+	if err := conn.WriteJSON(res); err != nil {
+		return true, err
+	}
+	close(ready)
+	return true, nil
+}
+
+// writeResponses writes the responses of the RPC server to the connection.
+func (s *ipcServer) writeResponses(conn *ipcConn, clientEnd net.Conn) {
+	dec := json.NewDecoder(clientEnd)
+	for {
+		var res json.RawMessage
+		if err := dec.Decode(&res); err != nil {
+			return
+		}
+		if err := conn.WriteJSON(res); err != nil {
+			s.logger.Debug("error writing IPC response", "error", err.Error())
+			_ = clientEnd.Close()
+			return
+		}
+	}
+}
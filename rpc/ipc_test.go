@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/stream"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+type echoService struct{}
+
+func (echoService) Echo(s string) string { return s }
+
+func startTestIPCServer(t *testing.T, rpcStream *stream.RPCStream) string {
+	t.Helper()
+
+	// unix socket paths are limited to about 100 bytes, keep the directory short
+	dir, err := os.MkdirTemp("", "ipc")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	path := filepath.Join(dir, "evmd.ipc")
+
+	rpcServer := ethrpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("test", echoService{}))
+
+	srv := NewIPCServer(client.Context{}, log.NewNopLogger(), rpcStream, nil, rpcServer, path, 0o600)
+	require.NoError(t, srv.Start())
+	t.Cleanup(srv.Stop)
+	return path
+}
+
+func TestIPCServerCall(t *testing.T) {
+	path := startTestIPCServer(t, &stream.RPCStream{})
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	cli, err := ethrpc.DialIPC(context.Background(), path)
+	require.NoError(t, err)
+	defer cli.Close()
+
+	var res string
+	require.NoError(t, cli.Call(&res, "test_echo", "hello"))
+	require.Equal(t, "hello", res)
+
+	var batch [2]string
+	require.NoError(t, cli.BatchCall([]ethrpc.BatchElem{
+		{Method: "test_echo", Args: []interface{}{"a"}, Result: &batch[0]},
+		{Method: "test_echo", Args: []interface{}{"b"}, Result: &batch[1]},
+	}))
+	require.Equal(t, [2]string{"a", "b"}, batch)
+}
+
+func TestIPCServerSubscription(t *testing.T) {
+	blocks := make(chan coretypes.ResultEvent, 1)
+	rpcStream := stream.NewRPCStreams(&mockEventsClient{blocks: blocks}, log.NewNopLogger(), nil)
+	path := startTestIPCServer(t, rpcStream)
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	enc, dec := json.NewEncoder(conn), json.NewDecoder(conn)
+	require.NoError(t, enc.Encode(map[string]interface{}{
+		"jsonrpc": "2.0", "id": 1, "method": "eth_subscribe", "params": []interface{}{"newHeads"},
+	}))
+	var ack SubscriptionResponseJSON
+	require.NoError(t, dec.Decode(&ack))
+	subID, ok := ack.Result.(string)
+	require.True(t, ok)
+
+	blocks <- coretypes.ResultEvent{Data: cmttypes.EventDataNewBlock{
+		Block:   &cmttypes.Block{Header: cmttypes.Header{Height: 7}},
+		BlockID: cmttypes.BlockID{Hash: make([]byte, 32)},
+	}}
+
+	var note SubscriptionNotification
+	require.NoError(t, dec.Decode(&note))
+	require.Equal(t, "eth_subscription", note.Method)
+	require.Equal(t, ethrpc.ID(subID), note.Params.Subscription)
+
+	require.NoError(t, enc.Encode(map[string]interface{}{
+		"jsonrpc": "2.0", "id": 2, "method": "eth_unsubscribe", "params": []interface{}{subID},
+	}))
+	var unsub SubscriptionResponseJSON
+	require.NoError(t, dec.Decode(&unsub))
+	require.Equal(t, true, unsub.Result)
+}
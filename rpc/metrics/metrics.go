@@ -0,0 +1,313 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+	"unicode"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/cosmos/evm/rpc/ratelimit"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+const (
+	// unknownMethod labels the calls to the methods which are not registered,
+	// so that the clients can't create metrics at will
+	unknownMethod = "unknown"
+)
+
+// Metrics records the requests, the latency, the errors by code and the
+// in-flight calls of each JSON-RPC method and transport in the geth metrics
+// registry, which is exposed by the JSON-RPC metrics server under
+// rpc/method/<transport>/<method>/. A nil Metrics records nothing.
+type Metrics struct {
+	methods  map[string]bool
+	internal *rpctypes.InternalMarker
+}
+
+// NewMetrics creates a Metrics for the methods of the APIs registered on the
+// JSON-RPC server and the websocket subscription methods. It returns nil if
+// the metrics are disabled. The requests marked by internal are recorded with
+// the websocket transport.
+func NewMetrics(enable bool, apis []ethrpc.API, internal *rpctypes.InternalMarker) *Metrics {
+	if !enable {
+		return nil
+	}
+
+	methods := map[string]bool{
+		"eth_subscribe":   true,
+		"eth_unsubscribe": true,
+	}
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			methods[api.Namespace+"_"+formatName(typ.Method(i).Name)] = true
+		}
+	}
+	return &Metrics{
+		methods:  methods,
+		internal: internal,
+	}
+}
+
+// formatName lowercases the first letter of the method name, as done by the
+// go-ethereum rpc server when registering the methods.
+func formatName(name string) string {
+	ret := []rune(name)
+	if len(ret) > 0 {
+		ret[0] = unicode.ToLower(ret[0])
+	}
+	return string(ret)
+}
+
+// Start marks the start of a call to the method on the transport, the
+// returned function records the call with the JSON-RPC error code of the
+// response, 0 if it succeeded.
+func (m *Metrics) Start(transport ratelimit.Transport, method string) func(code int) {
+	if m == nil {
+		return func(int) {}
+	}
+
+	if !m.methods[method] {
+		method = unknownMethod
+	}
+	prefix := "rpc/method/" + string(transport) + "/" + method + "/"
+	inflight := gethmetrics.GetOrRegisterGauge(prefix+"inflight", nil)
+	inflight.Inc(1)
+	start := time.Now()
+
+	return func(code int) {
+		inflight.Dec(1)
+		gethmetrics.GetOrRegisterCounter(prefix+"requests", nil).Inc(1)
+		gethmetrics.GetOrRegisterTimer(prefix+"duration", nil).UpdateSince(start)
+		if code != 0 {
+			// metric names can't hold the minus sign of the reserved codes
+			if code < 0 {
+				code = -code
+			}
+			gethmetrics.GetOrRegisterCounter(prefix+"errors/"+strconv.Itoa(code), nil).Inc(1)
+		}
+	}
+}
+
+// maxScannedValue is the max length of the ids and error codes read from the
+// responses, the longer ones are ignored
+const maxScannedValue = 128
+
+// errorScanner reads the error codes of the JSON-RPC responses by id as the
+// response body is written, without holding the body. It only follows the
+// structure of the JSON values, the responses which are not valid JSON-RPC
+// responses hold no error.
+type errorScanner struct {
+	codes map[string]int
+
+	// depth is the nesting depth of the current value, and base the depth
+	// of the response objects: 1 for a single response and 2 for a batch
+	depth, base       int
+	inString, escaped bool
+
+	// inError is set within the error object of a response, and expectKey
+	// when the next string of the current object is a key
+	inError   bool
+	expectKey bool
+	// key is the last key of the current object
+	key        []byte
+	readingKey bool
+
+	// value is the id or error code being read, if capturing
+	capturing bool
+	value     []byte
+	id, code  []byte
+}
+
+// objectDepth returns the depth of the current object: the response or its
+// error object.
+func (s *errorScanner) objectDepth() int {
+	if s.inError {
+		return s.base + 1
+	}
+	return s.base
+}
+
+func (s *errorScanner) Write(bz []byte) (int, error) {
+	for _, c := range bz {
+		s.scan(c)
+	}
+	return len(bz), nil
+}
+
+func (s *errorScanner) scan(c byte) {
+	if s.inString {
+		if s.capturing {
+			s.capture(c)
+		}
+		switch {
+		case s.escaped:
+			s.escaped = false
+		case c == '\\':
+			s.escaped = true
+		case c == '"':
+			s.inString = false
+			s.readingKey = false
+			return
+		}
+		if s.readingKey && len(s.key) < maxScannedValue {
+			s.key = append(s.key, c)
+		}
+		return
+	}
+
+	if s.capturing {
+		if (c == ',' || c == '}') && s.depth == s.objectDepth() {
+			s.endCapture()
+		} else {
+			s.capture(c)
+		}
+	}
+
+	switch c {
+	case '"':
+		s.inString = true
+		if s.depth == s.objectDepth() && s.expectKey {
+			s.readingKey = true
+			s.key = s.key[:0]
+		}
+	case '{', '[':
+		s.depth++
+		switch {
+		case s.depth == 1:
+			s.base = 1
+			if c == '[' {
+				s.base = 2
+			}
+			if c == '{' {
+				s.startResponse()
+			}
+		case c == '{' && s.depth == s.base:
+			s.startResponse()
+		case c == '{' && s.depth == s.base+1 && !s.inError && !s.expectKey && string(s.key) == "error":
+			s.inError = true
+			s.expectKey = true
+		}
+	case '}', ']':
+		switch {
+		case c == '}' && s.inError && s.depth == s.base+1:
+			s.inError = false
+			s.expectKey = false
+		case c == '}' && s.depth == s.base:
+			s.endResponse()
+		}
+		s.depth--
+	case ':':
+		if s.depth == s.objectDepth() {
+			s.expectKey = false
+			key := string(s.key)
+			if (!s.inError && key == "id") || (s.inError && key == "code") {
+				s.capturing = true
+				s.value = s.value[:0]
+			}
+		}
+	case ',':
+		if s.depth == s.objectDepth() {
+			s.expectKey = true
+		}
+	}
+}
+
+func (s *errorScanner) capture(c byte) {
+	if len(s.value) <= maxScannedValue {
+		s.value = append(s.value, c)
+	}
+}
+
+func (s *errorScanner) endCapture() {
+	s.capturing = false
+	if len(s.value) > maxScannedValue {
+		return
+	}
+	value := bytes.TrimSpace(s.value)
+	if s.inError {
+		s.code = append(s.code[:0], value...)
+	} else {
+		s.id = append(s.id[:0], value...)
+	}
+}
+
+func (s *errorScanner) startResponse() {
+	s.inError = false
+	s.expectKey = true
+	s.capturing = false
+	s.id, s.code = s.id[:0], nil
+}
+
+func (s *errorScanner) endResponse() {
+	if s.code == nil {
+		return
+	}
+	code, err := strconv.Atoi(string(s.code))
+	if err != nil {
+		return
+	}
+	if s.codes == nil {
+		s.codes = make(map[string]int)
+	}
+	s.codes[string(s.id)] = code
+}
+
+// responseRecorder scans the response body written to the client for the
+// error codes.
+type responseRecorder struct {
+	http.ResponseWriter
+	errors errorScanner
+}
+
+func (r *responseRecorder) Write(bz []byte) (int, error) {
+	_, _ = r.errors.Write(bz)
+	return r.ResponseWriter.Write(bz)
+}
+
+// Handler wraps the http handler of the JSON-RPC server to record its calls.
+// The calls of a batch are recorded with the latency of the whole batch.
+// Requests larger than bodyLimit or which can't be decoded are not recorded.
+func (m *Metrics) Handler(next http.Handler, bodyLimit int) http.Handler {
+	if m == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := rpctypes.PeekRequestBody(r, bodyLimit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		calls, _, err := rpctypes.DecodeJSONRPCCalls(body)
+		if len(body) > bodyLimit || err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		transport := ratelimit.TransportHTTP
+		// the calls forwarded by the websocket server are recorded with the
+		// websocket transport
+		if internal, _ := m.internal.Internal(r); internal {
+			transport = ratelimit.TransportWS
+		}
+		done := make([]func(int), len(calls))
+		for i, call := range calls {
+			done[i] = m.Start(transport, call.Method)
+		}
+
+		rec := &responseRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+
+		codes := rec.errors.codes
+		for i, call := range calls {
+			done[i](codes[string(call.ID)])
+		}
+	})
+}
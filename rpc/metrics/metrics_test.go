@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/ratelimit"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+type testService struct{}
+
+func (testService) BlockNumber() (uint64, error) { return 1, nil }
+
+func (testService) Fail() error { return errors.New("failed") }
+
+func count(name string) int64 {
+	return gethmetrics.GetOrRegisterCounter(name, nil).Snapshot().Count()
+}
+
+func newTestHandler(t *testing.T) (*Metrics, http.Handler) {
+	t.Helper()
+	// the latency histograms are only sampled once enabled, as done by the server
+	gethmetrics.Enable()
+
+	apis := []ethrpc.API{{Namespace: "test", Service: testService{}}}
+	server := ethrpc.NewServer()
+	for _, api := range apis {
+		require.NoError(t, server.RegisterName(api.Namespace, api.Service))
+	}
+	m := NewMetrics(true, apis, rpctypes.NewInternalMarker())
+	return m, m.Handler(server, 1024)
+}
+
+func serve(handler http.Handler, body string, internal func(*http.Request)) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if internal != nil {
+		internal(req)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestDisabledMetrics(t *testing.T) {
+	m := NewMetrics(false, nil, nil)
+	require.Nil(t, m)
+
+	next := ethrpc.NewServer()
+	require.Equal(t, http.Handler(next), m.Handler(next, 1024))
+	m.Start(ratelimit.TransportWS, "eth_subscribe")(0)
+}
+
+func TestHandlerRecordsCalls(t *testing.T) {
+	m, handler := newTestHandler(t)
+
+	serve(handler, `[
+		{"jsonrpc":"2.0","id":1,"method":"test_blockNumber"},
+		{"jsonrpc":"2.0","id":2,"method":"test_fail"},
+		{"jsonrpc":"2.0","id":3,"method":"test_missing"}
+	]`, nil)
+
+	require.Equal(t, int64(1), count("rpc/method/http/test_blockNumber/requests"))
+	require.Equal(t, int64(1), count("rpc/method/http/test_fail/requests"))
+	require.Equal(t, int64(1), count("rpc/method/http/test_fail/errors/32000"))
+	// the methods which are not registered share the unknown label
+	require.Equal(t, int64(1), count("rpc/method/http/unknown/requests"))
+	require.Equal(t, int64(1), count("rpc/method/http/unknown/errors/32601"))
+	require.Equal(t, int64(0), count("rpc/method/http/test_blockNumber/errors/32000"))
+	require.Equal(t, int64(1), gethmetrics.GetOrRegisterTimer("rpc/method/http/test_blockNumber/duration", nil).Snapshot().Count())
+	require.Equal(t, int64(0), gethmetrics.GetOrRegisterGauge("rpc/method/http/test_blockNumber/inflight", nil).Snapshot().Value())
+
+	// the calls forwarded by the websocket server are recorded with the websocket transport
+	serve(handler, `{"jsonrpc":"2.0","id":"a","method":"test_blockNumber"}`, func(r *http.Request) {
+		m.internal.Mark(r, false)
+	})
+	require.Equal(t, int64(1), count("rpc/method/http/test_blockNumber/requests"))
+	require.Equal(t, int64(1), count("rpc/method/ws/test_blockNumber/requests"))
+
+	// a forged token is ignored
+	serve(handler, `{"jsonrpc":"2.0","id":"a","method":"test_blockNumber"}`, func(r *http.Request) {
+		rpctypes.NewInternalMarker().Mark(r, false)
+	})
+	require.Equal(t, int64(2), count("rpc/method/http/test_blockNumber/requests"))
+}
+
+func TestStartRecordsInflight(t *testing.T) {
+	m, _ := newTestHandler(t)
+	inflight := gethmetrics.GetOrRegisterGauge("rpc/method/ws/eth_subscribe/inflight", nil)
+
+	done := m.Start(ratelimit.TransportWS, "eth_subscribe")
+	require.Equal(t, int64(1), inflight.Snapshot().Value())
+	done(-32600)
+	require.Equal(t, int64(0), inflight.Snapshot().Value())
+	require.Equal(t, int64(1), count("rpc/method/ws/eth_subscribe/errors/32600"))
+}
+
+func TestErrorScanner(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expCodes map[string]int
+	}{
+		{"without error", `{"jsonrpc":"2.0","id":1,"result":{"error":{"code":1}}}`, nil},
+		{"single error", `{"jsonrpc":"2.0","id":"a","error":{"code":-32000,"message":"failed"}}`, map[string]int{`"a"`: -32000}},
+		{
+			"batch",
+			`[{"jsonrpc":"2.0","id":1,"result":"0x1"},` +
+				` {"jsonrpc": "2.0", "error": {"message": "a \"}\" b", "data": {"code": 5}, "code": 3}, "id": "x,}"},` +
+				`{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"missing"}}]`,
+			map[string]int{`"x,}"`: 3, "2": -32601},
+		},
+		{"invalid code", `{"jsonrpc":"2.0","id":1,"error":{"code":"a"}}`, nil},
+		{"not a response", `["error",{"code":1}]`, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// the body is scanned as written, in any chunks
+			var s errorScanner
+			for _, c := range []byte(tc.body) {
+				_, err := s.Write([]byte{c})
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expCodes, s.codes)
+		})
+	}
+}
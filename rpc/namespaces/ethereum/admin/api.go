@@ -0,0 +1,252 @@
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/admin")
+
+// ErrAddPeerDisabled is returned by admin_addPeer when adding peers is not
+// allowed by the node configuration.
+var ErrAddPeerDisabled = errors.New("adding peers is disabled, set json-rpc.allow-add-peer to enable it")
+
+// NodeInfo represents a short summary of the information known about the
+// host, in the format of geth.
+type NodeInfo struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Enode string `json:"enode"`
+	ENR   string `json:"enr"`
+	IP    string `json:"ip"`
+	Ports struct {
+		Discovery int `json:"discovery"`
+		Listener  int `json:"listener"`
+	} `json:"ports"`
+	ListenAddr string                 `json:"listenAddr"`
+	Protocols  map[string]interface{} `json:"protocols"`
+}
+
+// PeerInfo represents a short summary of the information known about a
+// connected peer, in the format of geth.
+type PeerInfo struct {
+	ENR     string   `json:"enr,omitempty"`
+	Enode   string   `json:"enode"`
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Caps    []string `json:"caps"`
+	Network struct {
+		LocalAddress  string `json:"localAddress"`
+		RemoteAddress string `json:"remoteAddress"`
+		Inbound       bool   `json:"inbound"`
+		Trusted       bool   `json:"trusted"`
+		Static        bool   `json:"static"`
+	} `json:"network"`
+	Protocols map[string]interface{} `json:"protocols"`
+}
+
+// peerDialer dials peers, it is implemented by the in-process CometBFT client.
+type peerDialer interface {
+	DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error)
+}
+
+// API is the admin_ prefixed set of APIs, mapping the CometBFT node and peer
+// information to the geth responses.
+type API struct {
+	logger       log.Logger
+	tmClient     rpcclient.Client
+	dataDir      string
+	evmChainID   uint64
+	allowAddPeer bool
+}
+
+// NewAPI creates an instance of the admin API.
+func NewAPI(ctx *server.Context, clientCtx client.Context, evmChainID uint64, allowAddPeer bool) *API {
+	return &API{
+		logger:       ctx.Logger.With("api", "admin"),
+		tmClient:     clientCtx.Client.(rpcclient.Client),
+		dataDir:      ctx.Config.RootDir,
+		evmChainID:   evmChainID,
+		allowAddPeer: allowAddPeer,
+	}
+}
+
+// NodeInfo returns the information of the node, the enode identifies the node
+// with its CometBFT node ID.
+func (a *API) NodeInfo() (*NodeInfo, error) {
+	a.logger.Debug("admin_nodeInfo")
+	ctx, span := tracer.Start(context.Background(), "NodeInfo")
+	defer span.End()
+
+	status, err := a.tmClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo := status.NodeInfo
+	host, port := splitListenAddr(nodeInfo.ListenAddr)
+	info := &NodeInfo{
+		ID:         string(nodeInfo.ID()),
+		Name:       nodeName(nodeInfo),
+		Enode:      enodeURL(nodeInfo.ID(), host, port),
+		IP:         host,
+		ListenAddr: net.JoinHostPort(host, strconv.Itoa(port)),
+		Protocols: map[string]interface{}{
+			"eth": map[string]interface{}{
+				"network": a.evmChainID,
+			},
+			"cometbft": map[string]interface{}{
+				"network":         nodeInfo.Network,
+				"version":         nodeInfo.Version,
+				"latestBlockHash": status.SyncInfo.LatestBlockHash.String(),
+				"latestHeight":    status.SyncInfo.LatestBlockHeight,
+				"catchingUp":      status.SyncInfo.CatchingUp,
+			},
+		},
+	}
+	info.Ports.Listener = port
+	return info, nil
+}
+
+// Peers returns the information of the connected peers.
+func (a *API) Peers() ([]*PeerInfo, error) {
+	a.logger.Debug("admin_peers")
+	ctx, span := tracer.Start(context.Background(), "Peers")
+	defer span.End()
+
+	netInfo, err := a.tmClient.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		peers = append(peers, newPeerInfo(peer))
+	}
+	return peers, nil
+}
+
+// AddPeer dials the peer and adds it to the persistent peers of the node. The
+// peer is either an enode URL built from the CometBFT node ID, as returned by
+// admin_nodeInfo, or a CometBFT peer address id@host:port.
+func (a *API) AddPeer(peerURL string) (bool, error) {
+	a.logger.Debug("admin_addPeer", "url", peerURL)
+	ctx, span := tracer.Start(context.Background(), "AddPeer")
+	defer span.End()
+
+	if !a.allowAddPeer {
+		return false, ErrAddPeerDisabled
+	}
+
+	dialer, ok := a.tmClient.(peerDialer)
+	if !ok {
+		return false, fmt.Errorf("client %T cannot dial peers", a.tmClient)
+	}
+
+	peer, err := peerAddress(peerURL)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := dialer.DialPeers(ctx, []string{peer}, true, false, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Datadir returns the home directory of the node.
+func (a *API) Datadir() string {
+	a.logger.Debug("admin_datadir")
+	return a.dataDir
+}
+
+// newPeerInfo converts the CometBFT peer to the geth peer information.
+func newPeerInfo(peer coretypes.Peer) *PeerInfo {
+	nodeInfo := peer.NodeInfo
+	_, port := splitListenAddr(nodeInfo.ListenAddr)
+	info := &PeerInfo{
+		Enode: enodeURL(nodeInfo.ID(), peer.RemoteIP, port),
+		ID:    string(nodeInfo.ID()),
+		Name:  nodeName(nodeInfo),
+		Caps: []string{
+			fmt.Sprintf("p2p/%d", nodeInfo.ProtocolVersion.P2P),
+			fmt.Sprintf("block/%d", nodeInfo.ProtocolVersion.Block),
+			fmt.Sprintf("app/%d", nodeInfo.ProtocolVersion.App),
+		},
+		Protocols: map[string]interface{}{
+			"cometbft": map[string]interface{}{
+				"network": nodeInfo.Network,
+				"version": nodeInfo.Version,
+			},
+		},
+	}
+	// the port of the inbound connections is not known, the listen port of the
+	// peer is used instead
+	info.Network.RemoteAddress = net.JoinHostPort(peer.RemoteIP, strconv.Itoa(port))
+	info.Network.Inbound = !peer.IsOutbound
+	return info
+}
+
+// nodeName returns the name of the node, made of its moniker and CometBFT version.
+func nodeName(nodeInfo p2p.DefaultNodeInfo) string {
+	return fmt.Sprintf("%s/v%s", nodeInfo.Moniker, nodeInfo.Version)
+}
+
+// enodeURL returns the enode-like URL of the node, identified by its CometBFT
+// node ID instead of its public key.
+func enodeURL(id p2p.ID, host string, port int) string {
+	return fmt.Sprintf("enode://%s@%s", id, net.JoinHostPort(host, strconv.Itoa(port)))
+}
+
+// splitListenAddr returns the host and the port of the listen address of a
+// node, e.g. tcp://0.0.0.0:26656.
+func splitListenAddr(listenAddr string) (string, int) {
+	if i := strings.Index(listenAddr, "://"); i >= 0 {
+		listenAddr = listenAddr[i+3:]
+	}
+	host, portStr, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return listenAddr, 0
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return host, 0
+	}
+	return host, port
+}
+
+// peerAddress returns the CometBFT address id@host:port of the peer URL.
+func peerAddress(peerURL string) (string, error) {
+	if !strings.Contains(peerURL, "://") {
+		peerURL = "enode://" + peerURL
+	}
+	u, err := url.Parse(peerURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid peer url %q: %w", peerURL, err)
+	}
+	if u.Scheme != "enode" || u.User == nil || u.Port() == "" {
+		return "", fmt.Errorf("invalid peer url %q, expected enode://id@host:port", peerURL)
+	}
+	id := u.User.Username()
+	if bz, err := hex.DecodeString(id); err != nil || len(bz) != p2p.IDByteLength {
+		return "", fmt.Errorf("invalid peer url %q, node id must be %d hex encoded bytes", peerURL, p2p.IDByteLength)
+	}
+	return p2p.IDAddressString(p2p.ID(id), u.Host), nil
+}
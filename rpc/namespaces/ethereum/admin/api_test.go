@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/p2p"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+const testNodeID = "5d1d8d1ab2c9a6c5b0b5d2a4f0c8a2c1d7e2d4f1"
+
+func TestPeerAddress(t *testing.T) {
+	testCases := []struct {
+		name     string
+		url      string
+		expected string
+		expErr   bool
+	}{
+		{"enode url", "enode://" + testNodeID + "@10.0.0.1:26656", testNodeID + "@10.0.0.1:26656", false},
+		{"enode url with query", "enode://" + testNodeID + "@10.0.0.1:26656?discport=0", testNodeID + "@10.0.0.1:26656", false},
+		{"cometbft address", testNodeID + "@seed.example.com:26656", testNodeID + "@seed.example.com:26656", false},
+		{"missing port", "enode://" + testNodeID + "@10.0.0.1", "", true},
+		{"missing node id", "enode://10.0.0.1:26656", "", true},
+		{"invalid node id", "enode://abcd@10.0.0.1:26656", "", true},
+		{"invalid scheme", "tcp://" + testNodeID + "@10.0.0.1:26656", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := peerAddress(tc.url)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, addr)
+		})
+	}
+}
+
+func TestNewPeerInfo(t *testing.T) {
+	peer := coretypes.Peer{
+		NodeInfo: p2p.DefaultNodeInfo{
+			ProtocolVersion: p2p.NewProtocolVersion(8, 11, 0),
+			DefaultNodeID:   testNodeID,
+			ListenAddr:      "tcp://0.0.0.0:26656",
+			Network:         "cosmos_262144-1",
+			Version:         "0.39.3",
+			Moniker:         "validator",
+		},
+		IsOutbound: true,
+		RemoteIP:   "10.0.0.2",
+	}
+
+	info := newPeerInfo(peer)
+	require.Equal(t, testNodeID, info.ID)
+	require.Equal(t, "enode://"+testNodeID+"@10.0.0.2:26656", info.Enode)
+	require.Equal(t, "validator/v0.39.3", info.Name)
+	require.Equal(t, []string{"p2p/8", "block/11", "app/0"}, info.Caps)
+	require.Equal(t, "10.0.0.2:26656", info.Network.RemoteAddress)
+	require.False(t, info.Network.Inbound)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	stderrors "github.com/pkg/errors"
@@ -188,6 +189,65 @@ func (a *API) GetRawBlock(ctx context.Context, blockNrOrHash rpctypes.BlockNumbe
 	return rlp.EncodeToBytes(block)
 }
 
+// StorageRangeAt returns the storage of the contract at the given block and
+// transaction index, starting from the keyStart storage key. Only the state at
+// the start of the block is available, so the transaction index must be 0.
+func (a *API) StorageRangeAt(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (_ rpctypes.StorageRangeResult, err error) {
+	a.logger.Debug("debug_storageRangeAt", "block number or hash", blockNrOrHash, "txIndex", txIndex, "address", contractAddress)
+	ctx, span := tracer.Start(ctx, "eth_storageRangeAt")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if txIndex != 0 {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("state at transaction index %d is not available, only the state at the start of the block is", txIndex)
+	}
+
+	blockNum, err := a.backend.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	header, err := a.backend.HeaderByNumber(ctx, blockNum)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("block %v not found", blockNrOrHash)
+	}
+
+	// the state at the start of the block is the state committed by its parent
+	parent := rpctypes.BlockNumber(header.Number.Int64() - 1)
+	return a.backend.StorageRangeAt(ctx, contractAddress, keyStart, maxResult, rpctypes.BlockNumberOrHash{BlockNumber: &parent})
+}
+
+// AccountRange returns up to maxResults accounts at the given block, starting
+// from the start address. All the accounts have an address, so incompletes is
+// ignored.
+func (a *API) AccountRange(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (_ state.Dump, err error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "maxResults", maxResults)
+	ctx, span := tracer.Start(ctx, "eth_accountRange")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.AccountRange(ctx, blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
+// DumpBlock returns the first accounts at the given block, with their code and
+// storage. The next address to iterate the following accounts from with
+// AccountRange is set if the dump is partial.
+func (a *API) DumpBlock(ctx context.Context, blockNr rpctypes.BlockNumber) (_ state.Dump, err error) {
+	a.logger.Debug("debug_dumpBlock", "block number", blockNr)
+	ctx, span := tracer.Start(ctx, "eth_dumpBlock")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.AccountRange(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, nil, 0, false, false)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -3,6 +3,8 @@ package eth
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -13,6 +15,7 @@ import (
 	"go.opentelemetry.io/otel"
 
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -54,9 +57,12 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionSync(ctx context.Context, data hexutil.Bytes, timeoutMs *hexutil.Uint64) (map[string]interface{}, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
-	// eth_sendPrivateTransaction
-	// eth_cancel	PrivateTransaction
+	SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error)
+	CallBundle(args rpctypes.CallBundleArgs) (*rpctypes.CallBundleResult, error)
+	SendPrivateTransaction(args rpctypes.PrivateTxArgs) (common.Hash, error)
+	CancelPrivateTransaction(args rpctypes.CancelPrivateTxArgs) (bool, error)
 
 	// Account Information
 	//
@@ -66,12 +72,15 @@ type EthereumAPI interface {
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
+	GetAccount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountInfo, error)
+	GetAccounts(addresses []common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.AccountInfo, error)
 
 	// EVM/Smart Contract Execution
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Chain Information
 	//
@@ -97,10 +106,10 @@ type EthereumAPI interface {
 	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
 	FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
+	SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (*rpctypes.AccessListResult, error)
 
-	// eth_signTransaction (on Ethereum.org)
 	// eth_getCompilers (on Ethereum.org)
 	// eth_compileSolidity (on Ethereum.org)
 	// eth_compileLLL (on Ethereum.org)
@@ -116,13 +125,15 @@ var _ EthereumAPI = (*PublicAPI)(nil)
 type PublicAPI struct {
 	logger  log.Logger
 	backend backend.EVMBackend
+	events  *stream.RPCStream
 }
 
 // NewPublicAPI creates an instance of the public ETH Web3 API.
-func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend, events *stream.RPCStream) *PublicAPI {
 	api := &PublicAPI{
 		logger:  logger.With("client", "json-rpc"),
 		backend: backend,
+		events:  events,
 	}
 
 	return api
@@ -265,6 +276,60 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (_ common.Hash, err e
 	return e.backend.SendRawTransaction(ctx, data)
 }
 
+// SendRawTransactionSync sends a raw Ethereum transaction and waits until it is
+// included in a block to return its receipt, as defined by EIP-7966. The
+// optional timeout is in milliseconds and is capped by the node configuration.
+func (e *PublicAPI) SendRawTransactionSync(ctx context.Context, data hexutil.Bytes, timeoutMs *hexutil.Uint64) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_sendRawTransactionSync")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendRawTransactionSync", "length", len(data), "timeout", timeoutMs)
+
+	maxTimeout := e.backend.RPCTxSyncMaxTimeout()
+	timeout := min(e.backend.RPCTxSyncDefaultTimeout(), maxTimeout)
+	if timeoutMs != nil && *timeoutMs > 0 {
+		timeout = maxTimeout
+		if *timeoutMs < hexutil.Uint64(maxTimeout.Milliseconds()) { //nolint:gosec // G115 // max timeout is not negative
+			timeout = time.Duration(*timeoutMs) * time.Millisecond //nolint:gosec // G115 // lower than the max timeout
+		}
+	}
+
+	// read the header stream offset before broadcasting the tx, so the block
+	// including it can't be missed
+	headers := e.events.HeaderStream()
+	_, offset := headers.ReadNonBlocking(-1)
+
+	hash, err := e.backend.SendRawTransaction(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		var items []stream.RPCHeader
+		items, offset = headers.ReadBlocking(waitCtx, offset)
+		if len(items) == 0 {
+			if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+				return nil, &rpctypes.TxSyncTimeoutError{Hash: hash, Timeout: timeout}
+			}
+			return nil, waitCtx.Err()
+		}
+
+		// only query the receipt once the tx is found, as the receipt query
+		// retries for a while when the tx is unknown
+		if _, err := e.backend.GetTxByEthHash(waitCtx, hash); err != nil {
+			continue
+		}
+		receipt, err := e.backend.GetTransactionReceipt(waitCtx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+	}
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (_ common.Hash, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_sendTransaction")
@@ -273,6 +338,52 @@ func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (_ common.Has
 	return e.backend.SendTransaction(ctx, args)
 }
 
+// SendBundle adds a bundle of signed txs to the mempool, to be included
+// atomically in the target block if none of them fails.
+func (e *PublicAPI) SendBundle(args rpctypes.SendBundleArgs) (_ *rpctypes.SendBundleResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_sendBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendBundle", "txs", len(args.Txs), "block number", args.BlockNumber)
+
+	hash, err := e.backend.SendBundle(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return &rpctypes.SendBundleResult{BundleHash: hash}, nil
+}
+
+// CallBundle simulates a bundle of signed txs on top of a block and returns
+// the result of each tx.
+func (e *PublicAPI) CallBundle(args rpctypes.CallBundleArgs) (_ *rpctypes.CallBundleResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_callBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_callBundle", "txs", len(args.Txs), "block number", args.BlockNumber, "state block number", args.StateBlockNumber)
+
+	return e.backend.CallBundle(ctx, args)
+}
+
+// SendPrivateTransaction adds a signed tx to the mempool without broadcasting
+// it, to be included in the blocks proposed by this node or its trusted
+// validators up to the max block number.
+func (e *PublicAPI) SendPrivateTransaction(args rpctypes.PrivateTxArgs) (_ common.Hash, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_sendPrivateTransaction")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendPrivateTransaction", "length", len(args.Tx), "max block number", args.MaxBlockNumber)
+
+	return e.backend.SendPrivateTransaction(ctx, args)
+}
+
+// CancelPrivateTransaction drops a private tx from the mempool, given the
+// signature of its hash by its sender. It returns false if the mempool holds
+// no tx with the hash sent by the signer.
+func (e *PublicAPI) CancelPrivateTransaction(args rpctypes.CancelPrivateTxArgs) (_ bool, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_cancelPrivateTransaction")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_cancelPrivateTransaction", "hash", args.TxHash)
+
+	return e.backend.CancelPrivateTransaction(ctx, args)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Account Information				                    ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -318,6 +429,23 @@ func (e *PublicAPI) GetProof(address common.Address,
 	return e.backend.GetProof(ctx, address, storageKeys, blockNrOrHash)
 }
 
+// GetAccount returns the nonce, balance, code hash and storage root of the account at the given block.
+func (e *PublicAPI) GetAccount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ *rpctypes.AccountInfo, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_getAccount")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_getAccount", "address", address.Hex(), "block number or hash", blockNrOrHash)
+	return e.backend.GetAccount(ctx, address, blockNrOrHash)
+}
+
+// GetAccounts returns the nonce, balance, code hash and storage root of the accounts at the given block,
+// in the order of the addresses.
+func (e *PublicAPI) GetAccounts(addresses []common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ []*rpctypes.AccountInfo, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_getAccounts")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_getAccounts", "addresses", len(addresses), "block number or hash", blockNrOrHash)
+	return e.backend.GetAccounts(ctx, addresses, blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           EVM/Smart Contract Execution				          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -344,6 +472,20 @@ func (e *PublicAPI) Call(
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes series of transactions on top of a base state. The
+// transactions are packed into blocks and the state and block overrides can be
+// set per block.
+func (e *PublicAPI) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (_ []map[string]interface{}, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_simulateV1")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	return e.backend.SimulateV1(ctx, opts, blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -458,6 +600,15 @@ func (e *PublicAPI) Sign(address common.Address, data hexutil.Bytes) (hexutil.By
 	return e.backend.Sign(address, data)
 }
 
+// SignTransaction signs the given transaction with the key of the from account,
+// without broadcasting it. The gas, fees and nonce must be set.
+func (e *PublicAPI) SignTransaction(args evmtypes.TransactionArgs) (_ *rpctypes.SignTransactionResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_signTransaction")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_signTransaction", "args", args)
+	return e.backend.SignTransaction(ctx, args)
+}
+
 // GetTransactionLogs returns the logs given a transaction hash.
 func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) (_ []*ethtypes.Log, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_getTransactionLogs")
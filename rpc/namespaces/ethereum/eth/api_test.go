@@ -0,0 +1,140 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+// mockEventsClient feeds the block subscription of the rpc streams with the
+// events pushed onto blocks, other subscriptions get an unused channel.
+type mockEventsClient struct {
+	blocks chan coretypes.ResultEvent
+}
+
+func (m *mockEventsClient) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	if query == cmttypes.QueryForEvent(cmttypes.EventNewBlock).String() {
+		return m.blocks, nil
+	}
+	return make(chan coretypes.ResultEvent), nil
+}
+
+func (m *mockEventsClient) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (m *mockEventsClient) UnsubscribeAll(context.Context, string) error { return nil }
+
+// txSyncBackend is the part of the backend used by eth_sendRawTransactionSync.
+// The sent tx is included in the next block pushed onto blocks if include is
+// set.
+type txSyncBackend struct {
+	backend.EVMBackend
+
+	defaultTimeout time.Duration
+	maxTimeout     time.Duration
+	hash           common.Hash
+	include        bool
+	blocks         chan coretypes.ResultEvent
+	included       atomic.Bool
+}
+
+func (b *txSyncBackend) RPCTxSyncDefaultTimeout() time.Duration { return b.defaultTimeout }
+
+func (b *txSyncBackend) RPCTxSyncMaxTimeout() time.Duration { return b.maxTimeout }
+
+func (b *txSyncBackend) SendRawTransaction(context.Context, hexutil.Bytes) (common.Hash, error) {
+	if b.include {
+		b.included.Store(true)
+		b.blocks <- coretypes.ResultEvent{Data: cmttypes.EventDataNewBlock{
+			Block: &cmttypes.Block{Header: cmttypes.Header{Height: 1}},
+		}}
+	}
+	return b.hash, nil
+}
+
+func (b *txSyncBackend) GetTxByEthHash(_ context.Context, hash common.Hash) (*servertypes.TxResult, error) {
+	if !b.included.Load() || hash != b.hash {
+		return nil, errors.New("tx not found")
+	}
+	return &servertypes.TxResult{Height: 1}, nil
+}
+
+func (b *txSyncBackend) GetTransactionReceipt(_ context.Context, hash common.Hash) (map[string]interface{}, error) {
+	return map[string]interface{}{"transactionHash": hash, "status": hexutil.Uint(1)}, nil
+}
+
+func newTxSyncTestAPI(t *testing.T, b *txSyncBackend) *PublicAPI {
+	t.Helper()
+	b.blocks = make(chan coretypes.ResultEvent, 1)
+	b.hash = common.HexToHash("0x01")
+	events := stream.NewRPCStreams(&mockEventsClient{blocks: b.blocks}, log.NewNopLogger(), nil)
+	return NewPublicAPI(log.NewNopLogger(), b, events)
+}
+
+func TestSendRawTransactionSync(t *testing.T) {
+	b := &txSyncBackend{defaultTimeout: 5 * time.Second, maxTimeout: 5 * time.Second, include: true}
+	api := newTxSyncTestAPI(t, b)
+
+	receipt, err := api.SendRawTransactionSync(context.Background(), hexutil.Bytes{0x01}, nil)
+	require.NoError(t, err)
+	require.Equal(t, b.hash, receipt["transactionHash"])
+}
+
+func TestSendRawTransactionSync_Timeout(t *testing.T) {
+	b := &txSyncBackend{defaultTimeout: 50 * time.Millisecond, maxTimeout: time.Second}
+	api := newTxSyncTestAPI(t, b)
+
+	_, err := api.SendRawTransactionSync(context.Background(), hexutil.Bytes{0x01}, nil)
+	var timeoutErr *rpctypes.TxSyncTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, 50*time.Millisecond, timeoutErr.Timeout)
+
+	// the tx hash is returned as error data with the EIP-7966 error code
+	var rpcErr rpc.Error
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, rpctypes.ErrCodeTxSyncTimeout, rpcErr.ErrorCode())
+	var dataErr rpc.DataError
+	require.ErrorAs(t, err, &dataErr)
+	require.Equal(t, b.hash.Hex(), dataErr.ErrorData())
+}
+
+func TestSendRawTransactionSync_MaxTimeout(t *testing.T) {
+	b := &txSyncBackend{defaultTimeout: 50 * time.Millisecond, maxTimeout: 100 * time.Millisecond}
+	api := newTxSyncTestAPI(t, b)
+	ms := func(v hexutil.Uint64) *hexutil.Uint64 { return &v }
+
+	testCases := []struct {
+		name      string
+		timeoutMs *hexutil.Uint64
+		expected  time.Duration
+	}{
+		{"no timeout requested", nil, 50 * time.Millisecond},
+		{"lower timeout requested", ms(20), 20 * time.Millisecond},
+		{"higher timeout requested", ms(60_000), 100 * time.Millisecond},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			_, err := api.SendRawTransactionSync(context.Background(), hexutil.Bytes{0x01}, tc.timeoutMs)
+			var timeoutErr *rpctypes.TxSyncTimeoutError
+			require.ErrorAs(t, err, &timeoutErr)
+			require.Equal(t, tc.expected, timeoutErr.Timeout)
+			require.Less(t, time.Since(start), 10*time.Second)
+		})
+	}
+}
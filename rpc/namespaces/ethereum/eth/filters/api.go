@@ -52,6 +52,7 @@ type Backend interface {
 	CometBlockResultByNumber(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(ctx context.Context, blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	GetLogBlocks(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
 	BlockBloomFromCometBlock(ctx context.Context, blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -22,6 +22,10 @@ import (
 	"cosmossdk.io/log/v2"
 )
 
+// indexedBlockRangeFactor is the factor of the block range cap allowed for the
+// ranges whose logs are indexed, as only the matching blocks are fetched.
+const indexedBlockRangeFactor = 10
+
 // BloomIV represents the bit indexes and value inside the bloom filter that belong
 // to some key.
 type BloomIV struct {
@@ -168,6 +172,19 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 		return nil, errInvalidBlockRange
 	}
 
+	// use the log indexes of the EVM tx indexer when they cover the range, a
+	// larger block range cap applies as only the matching blocks are fetched
+	if indexedLimit := blockLimit * indexedBlockRangeFactor; blockLimit > 0 && to-from > uint64(indexedLimit) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", indexedLimit)
+	}
+	heights, indexed, err := f.backend.GetLogBlocks(ctx, int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics) //#nosec G115
+	if err != nil {
+		return nil, fmt.Errorf("failed to query indexed log blocks: %w", err)
+	}
+	if indexed {
+		return f.indexedLogs(ctx, heights, logLimit)
+	}
+
 	if blockLimit > 0 && to-from > uint64(blockLimit) {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
@@ -199,6 +216,31 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 	return logs, nil
 }
 
+// indexedLogs returns the logs matching the filter criteria within the given
+// blocks, read from the EVM tx indexer.
+func (f *Filter) indexedLogs(ctx context.Context, heights []int64, logLimit int) ([]*ethtypes.Log, error) {
+	logs := []*ethtypes.Log{}
+	for _, height := range heights {
+		logsList, err := f.backend.GetLogsByHeight(ctx, &height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch logs block number %d: %w", height, err)
+		}
+
+		unfiltered := make([]*ethtypes.Log, 0)
+		for _, txLogs := range logsList {
+			unfiltered = append(unfiltered, txLogs...)
+		}
+		filtered := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+
+		// check logs limit
+		if len(logs)+len(filtered) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		logs = append(logs, filtered...)
+	}
+	return logs, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *cmtrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
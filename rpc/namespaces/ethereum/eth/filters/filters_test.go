@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -58,6 +59,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *filtermocks.Backend {
 				backend := &filtermocks.Backend{}
 				backend.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(fakeHeader, nil)
+				backend.EXPECT().GetLogBlocks(mock.Anything, blockHeight, blockHeight, mock.Anything, mock.Anything).Return(nil, false, nil)
 				backend.EXPECT().CometBlockResultByNumber(mock.Anything, &blockHeight).Return((*cmtrpctypes.ResultBlockResults)(nil), errors.New("block result error"))
 				return backend
 			},
@@ -74,6 +76,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *filtermocks.Backend {
 				backend := &filtermocks.Backend{}
 				backend.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(fakeHeader, nil)
+				backend.EXPECT().GetLogBlocks(mock.Anything, blockHeight, blockHeight, mock.Anything, mock.Anything).Return(nil, false, nil)
 				backend.EXPECT().CometBlockResultByNumber(mock.Anything, &blockHeight).Return(fakeBlockRes, nil)
 				backend.EXPECT().BlockBloomFromCometBlock(mock.Anything, fakeBlockRes).Return(ethtypes.Bloom{}, errors.New("bloom error"))
 				return backend
@@ -131,6 +134,9 @@ func TestLogs(t *testing.T) {
 
 func TestFilter(t *testing.T) {
 	logger := log.NewNopLogger()
+	address := common.HexToAddress("0x01")
+	matching := &ethtypes.Log{Address: address, BlockNumber: 7}
+	other := &ethtypes.Log{Address: common.HexToAddress("0x02"), BlockNumber: 7}
 	testCases := []struct {
 		name         string
 		filter       filters.FilterCriteria
@@ -146,6 +152,45 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "indexed logs allow a larger block range",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100), Addresses: []common.Address{address}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().GetLogBlocks(mock.Anything, int64(1), int64(100), []common.Address{address}, [][]common.Hash(nil)).Return([]int64{7}, true, nil)
+				height := int64(7)
+				b.EXPECT().GetLogsByHeight(mock.Anything, &height).Return([][]*ethtypes.Log{{matching, other}}, nil)
+			},
+			expLogs: []*ethtypes.Log{matching},
+		},
+		{
+			name:   "indexed logs exceeding the logs cap return error",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().GetLogBlocks(mock.Anything, int64(1), int64(100), []common.Address(nil), [][]common.Hash(nil)).Return([]int64{7}, true, nil)
+				height := int64(7)
+				b.EXPECT().GetLogsByHeight(mock.Anything, &height).Return([][]*ethtypes.Log{slices.Repeat([]*ethtypes.Log{matching}, 16)}, nil)
+			},
+			expErr: "query returned more than 15 results",
+		},
+		{
+			name:   "indexed range is bounded by the larger block range cap",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(600)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(600)}, nil)
+			},
+			expErr: "maximum [from, to] blocks distance: 500",
+		},
+		{
+			name:   "range not indexed is bounded by the block range cap",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().GetLogBlocks(mock.Anything, int64(1), int64(100), []common.Address(nil), [][]common.Hash(nil)).Return(nil, false, nil)
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -321,6 +321,75 @@ func (_c *Backend_GetBlockByNumber_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetLogBlocks provides a mock function with given fields: ctx, from, to, addresses, topics
+func (_m *Backend) GetLogBlocks(ctx context.Context, from int64, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error) {
+	ret := _m.Called(ctx, from, to, addresses, topics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLogBlocks")
+	}
+
+	var r0 []int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) ([]int64, bool, error)); ok {
+		return rf(ctx, from, to, addresses, topics)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) []int64); ok {
+		r0 = rf(ctx, from, to, addresses, topics)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) bool); ok {
+		r1 = rf(ctx, from, to, addresses, topics)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) error); ok {
+		r2 = rf(ctx, from, to, addresses, topics)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backend_GetLogBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogBlocks'
+type Backend_GetLogBlocks_Call struct {
+	*mock.Call
+}
+
+// GetLogBlocks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from int64
+//   - to int64
+//   - addresses []common.Address
+//   - topics [][]common.Hash
+func (_e *Backend_Expecter) GetLogBlocks(ctx interface{}, from interface{}, to interface{}, addresses interface{}, topics interface{}) *Backend_GetLogBlocks_Call {
+	return &Backend_GetLogBlocks_Call{Call: _e.mock.On("GetLogBlocks", ctx, from, to, addresses, topics)}
+}
+
+func (_c *Backend_GetLogBlocks_Call) Run(run func(ctx context.Context, from int64, to int64, addresses []common.Address, topics [][]common.Hash)) *Backend_GetLogBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]common.Address), args[4].([][]common.Hash))
+	})
+	return _c
+}
+
+func (_c *Backend_GetLogBlocks_Call) Return(_a0 []int64, _a1 bool, _a2 error) *Backend_GetLogBlocks_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Backend_GetLogBlocks_Call) RunAndReturn(run func(context.Context, int64, int64, []common.Address, [][]common.Hash) ([]int64, bool, error)) *Backend_GetLogBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLogs provides a mock function with given fields: ctx, blockHash
 func (_m *Backend) GetLogs(ctx context.Context, blockHash common.Hash) ([][]*types.Log, error) {
 	ret := _m.Called(ctx, blockHash)
@@ -0,0 +1,298 @@
+package ots
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/ots")
+
+const (
+	// apiLevel is the Otterscan API level implemented by the namespace.
+	apiLevel = 8
+	// maxPageSize is the max number of transactions returned by the address
+	// searches, larger pages are truncated.
+	maxPageSize = 25
+)
+
+// Backend defines the methods required by the ots API.
+type Backend interface {
+	GetBlockByNumber(ctx context.Context, blockNum types.BlockNumber, fullTx bool) (map[string]interface{}, error)
+	GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]interface{}, error)
+	GetBlockReceipts(ctx context.Context, blockNrOrHash types.BlockNumberOrHash) ([]map[string]interface{}, error)
+	HeaderByNumber(ctx context.Context, blockNum types.BlockNumber) (*ethtypes.Header, error)
+	GetTransactionByHash(ctx context.Context, txHash common.Hash) (*types.RPCTransaction, error)
+	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
+	TraceTransaction(ctx context.Context, hash common.Hash, config *types.TraceConfig) (interface{}, error)
+	SearchTxsByAddress(ctx context.Context, address common.Address, height int64, reverse bool, limit int) ([]common.Hash, bool, error)
+	GetTxHashBySenderAndNonce(ctx context.Context, sender common.Address, nonce uint64) (*common.Hash, error)
+	GetContractCreationTxHash(ctx context.Context, contract common.Address) (*common.Hash, error)
+}
+
+// API offers the Otterscan namespace, which is used by the Otterscan block
+// explorer. The address lookups are served by the EVM tx indexer.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new ots API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger.With("api", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the Otterscan API level implemented by the node.
+func (api *API) GetApiLevel() uint64 {
+	api.logger.Debug("ots_getApiLevel")
+	return apiLevel
+}
+
+// GetBlockDetails returns the header of the given block, along with its
+// transaction count and total fees.
+func (api *API) GetBlockDetails(blockNr types.BlockNumber) (_ *BlockDetails, err error) {
+	api.logger.Debug("ots_getBlockDetails", "number", blockNr)
+	ctx, span := tracer.Start(context.Background(), "ots_getBlockDetails", trace.WithAttributes(attribute.Int64("blockNr", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := api.backend.GetBlockByNumber(ctx, blockNr, false)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return api.blockDetails(ctx, block)
+}
+
+// GetBlockDetailsByHash returns the header of the given block, along with its
+// transaction count and total fees.
+func (api *API) GetBlockDetailsByHash(hash common.Hash) (_ *BlockDetails, err error) {
+	api.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_getBlockDetailsByHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := api.backend.GetBlockByHash(ctx, hash, false)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return api.blockDetails(ctx, block)
+}
+
+// SearchTransactionsBefore returns a page of the transactions of the address
+// in the blocks before the given one, from the newest to the oldest. A zero
+// block number starts from the latest block.
+func (api *API) SearchTransactionsBefore(address common.Address, blockNum uint64, pageSize uint16) (_ *TransactionsWithReceipts, err error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", address, "block", blockNum, "pageSize", pageSize)
+	ctx, span := tracer.Start(context.Background(), "ots_searchTransactionsBefore", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return api.searchTransactions(ctx, address, blockNum, pageSize, true)
+}
+
+// SearchTransactionsAfter returns a page of the transactions of the address
+// in the blocks after the given one, from the newest to the oldest. A zero
+// block number starts from the first block.
+func (api *API) SearchTransactionsAfter(address common.Address, blockNum uint64, pageSize uint16) (_ *TransactionsWithReceipts, err error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", address, "block", blockNum, "pageSize", pageSize)
+	ctx, span := tracer.Start(context.Background(), "ots_searchTransactionsAfter", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return api.searchTransactions(ctx, address, blockNum, pageSize, false)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the address with the given nonce.
+func (api *API) GetTransactionBySenderAndNonce(sender common.Address, nonce uint64) (_ *common.Hash, err error) {
+	api.logger.Debug("ots_getTransactionBySenderAndNonce", "sender", sender, "nonce", nonce)
+	ctx, span := tracer.Start(context.Background(), "ots_getTransactionBySenderAndNonce", trace.WithAttributes(attribute.String("sender", sender.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return api.backend.GetTxHashBySenderAndNonce(ctx, sender, nonce)
+}
+
+// GetContractCreator returns the transaction which deployed the contract and
+// its sender. Contracts deployed by other contracts are not indexed.
+func (api *API) GetContractCreator(address common.Address) (_ *ContractCreator, err error) {
+	api.logger.Debug("ots_getContractCreator", "address", address)
+	ctx, span := tracer.Start(context.Background(), "ots_getContractCreator", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	hash, err := api.backend.GetContractCreationTxHash(ctx, address)
+	if err != nil || hash == nil {
+		return nil, err
+	}
+	tx, err := api.backend.GetTransactionByHash(ctx, *hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %s not found", hash)
+	}
+	return &ContractCreator{Tx: *hash, Creator: tx.From}, nil
+}
+
+// TraceTransaction returns the call frames of the transaction, in execution
+// order.
+func (api *API) TraceTransaction(hash common.Hash) (_ []*TraceEntry, err error) {
+	api.logger.Debug("ots_traceTransaction", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_traceTransaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := api.callFrame(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	entries := []*TraceEntry{}
+	flattenCallFrame(frame, 0, &entries)
+	return entries, nil
+}
+
+// GetInternalOperations returns the value transfers, contract creations and
+// self destructs performed by contracts during the execution of the
+// transaction. Operations which have been reverted are omitted.
+func (api *API) GetInternalOperations(hash common.Hash) (_ []*InternalOperation, err error) {
+	api.logger.Debug("ots_getInternalOperations", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_getInternalOperations", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := api.callFrame(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	ops := []*InternalOperation{}
+	internalOperations(frame, 0, &ops)
+	return ops, nil
+}
+
+// blockDetails returns the details of a block formatted without transactions.
+func (api *API) blockDetails(ctx context.Context, block map[string]interface{}) (*BlockDetails, error) {
+	hashBz, ok := block["hash"].(hexutil.Bytes)
+	if !ok {
+		return nil, fmt.Errorf("invalid block hash type: %T", block["hash"])
+	}
+	hash := common.BytesToHash(hashBz)
+	receipts, err := api.backend.GetBlockReceipts(ctx, types.BlockNumberOrHash{BlockHash: &hash})
+	if err != nil {
+		return nil, err
+	}
+	totalFees, err := totalFees(receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	delete(block, "transactions")
+	block["transactionCount"] = len(txs)
+	block["logsBloom"] = nil
+
+	zero := (*hexutil.Big)(new(big.Int))
+	return &BlockDetails{
+		Block:     block,
+		Issuance:  Issuance{BlockReward: zero, UncleReward: zero, Issuance: zero},
+		TotalFees: (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// searchTransactions returns a page of the transactions of the address, with
+// their receipts extended with the block timestamp.
+func (api *API) searchTransactions(ctx context.Context, address common.Address, blockNum uint64, pageSize uint16, before bool) (*TransactionsWithReceipts, error) {
+	pageSize = min(pageSize, maxPageSize)
+	hashes, more, err := api.backend.SearchTxsByAddress(ctx, address, int64(blockNum), before, int(pageSize)) //nolint:gosec // G115
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TransactionsWithReceipts{
+		Txs:      make([]*types.RPCTransaction, 0, len(hashes)),
+		Receipts: make([]map[string]interface{}, 0, len(hashes)),
+	}
+	if before {
+		res.FirstPage, res.LastPage = blockNum == 0, !more
+	} else {
+		// pages are always returned from the newest to the oldest transaction
+		slices.Reverse(hashes)
+		res.FirstPage, res.LastPage = !more, blockNum == 0
+	}
+
+	timestamps := make(map[int64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := api.backend.GetTransactionByHash(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || tx.BlockNumber == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash)
+		}
+		receipt, err := api.backend.GetTransactionReceipt(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt %s not found", hash)
+		}
+
+		height := tx.BlockNumber.ToInt().Int64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			header, err := api.backend.HeaderByNumber(ctx, types.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			timestamp = hexutil.Uint64(header.Time)
+			timestamps[height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
+
+// callFrame returns the call frames of the transaction built by the callTracer.
+func (api *API) callFrame(ctx context.Context, hash common.Hash) (*callFrame, error) {
+	result, err := api.backend.TraceTransaction(ctx, hash, &types.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := types.DecodeTracerResult(result, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// totalFees returns the sum of the fees paid by the transactions of a block.
+func totalFees(receipts []map[string]interface{}) (*big.Int, error) {
+	total := new(big.Int)
+	for _, receipt := range receipts {
+		gasUsed, ok := receipt["gasUsed"].(hexutil.Uint64)
+		if !ok {
+			return nil, fmt.Errorf("invalid gas used type: %T", receipt["gasUsed"])
+		}
+		gasPrice, ok := receipt["effectiveGasPrice"].(*hexutil.Big)
+		if !ok || gasPrice == nil {
+			return nil, fmt.Errorf("invalid effective gas price type: %T", receipt["effectiveGasPrice"])
+		}
+		fee := new(big.Int).SetUint64(uint64(gasUsed))
+		total.Add(total, fee.Mul(fee, gasPrice.ToInt()))
+	}
+	return total, nil
+}
@@ -0,0 +1,47 @@
+package ots
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+)
+
+// searchBackend records the limit of the address searches.
+type searchBackend struct {
+	Backend
+	limit int
+}
+
+func (b *searchBackend) SearchTxsByAddress(_ context.Context, _ common.Address, _ int64, _ bool, limit int) ([]common.Hash, bool, error) {
+	b.limit = limit
+	return nil, false, nil
+}
+
+func TestSearchTransactionsPageSize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pageSize uint16
+		expLimit int
+	}{
+		{"page within the cap", 10, 10},
+		{"page over the cap", 10000, maxPageSize},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &searchBackend{}
+			api := NewAPI(log.NewNopLogger(), backend)
+
+			_, err := api.SearchTransactionsBefore(common.Address{}, 0, tc.pageSize)
+			require.NoError(t, err)
+			require.Equal(t, tc.expLimit, backend.limit)
+
+			_, err = api.SearchTransactionsAfter(common.Address{}, 0, tc.pageSize)
+			require.NoError(t, err)
+			require.Equal(t, tc.expLimit, backend.limit)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package ots
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/types"
+)
+
+// Internal operation types returned by ots_getInternalOperations.
+const (
+	OpTransfer     = 0
+	OpSelfDestruct = 1
+	OpCreate       = 2
+	OpCreate2      = 3
+)
+
+// TransactionsWithReceipts is a page of the transactions of an address, as
+// returned by ots_searchTransactionsBefore and ots_searchTransactionsAfter.
+type TransactionsWithReceipts struct {
+	Txs       []*types.RPCTransaction  `json:"txs"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+	FirstPage bool                     `json:"firstPage"`
+	LastPage  bool                     `json:"lastPage"`
+}
+
+// ContractCreator is the transaction which deployed a contract and its sender.
+type ContractCreator struct {
+	Tx      common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// TraceEntry is a single call frame returned by ots_traceTransaction.
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// InternalOperation is a value transfer, contract creation or self destruct
+// performed by a contract during the execution of a transaction.
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// BlockDetails is the result of ots_getBlockDetails.
+type BlockDetails struct {
+	Block     map[string]interface{} `json:"block"`
+	Issuance  Issuance               `json:"issuance"`
+	TotalFees *hexutil.Big           `json:"totalFees"`
+}
+
+// Issuance holds the block rewards, which are always zero as the native token
+// issuance is handled by the Cosmos SDK modules.
+type Issuance struct {
+	BlockReward *hexutil.Big `json:"blockReward"`
+	UncleReward *hexutil.Big `json:"uncleReward"`
+	Issuance    *hexutil.Big `json:"issuance"`
+}
+
+// callFrame is a call frame as returned by the callTracer.
+type callFrame struct {
+	Type   string          `json:"type"`
+	From   common.Address  `json:"from"`
+	To     *common.Address `json:"to"`
+	Value  *hexutil.Big    `json:"value"`
+	Input  hexutil.Bytes   `json:"input"`
+	Output hexutil.Bytes   `json:"output"`
+	Error  string          `json:"error"`
+	Calls  []*callFrame    `json:"calls"`
+}
@@ -0,0 +1,55 @@
+package ots
+
+import "math/big"
+
+// flattenCallFrame appends the call frame and all its sub calls, in execution
+// order, to the trace entries.
+func flattenCallFrame(frame *callFrame, depth int, entries *[]*TraceEntry) {
+	entry := &TraceEntry{
+		Type:   frame.Type,
+		Depth:  depth,
+		From:   frame.From,
+		Value:  frame.Value,
+		Input:  frame.Input,
+		Output: frame.Output,
+	}
+	if frame.To != nil {
+		entry.To = *frame.To
+	}
+	*entries = append(*entries, entry)
+	for _, call := range frame.Calls {
+		flattenCallFrame(call, depth+1, entries)
+	}
+}
+
+// internalOperations appends the value transfers, contract creations and self
+// destructs of the sub calls of the frame which have not been reverted.
+func internalOperations(frame *callFrame, depth int, ops *[]*InternalOperation) {
+	if frame.Error != "" {
+		return
+	}
+	if depth > 0 {
+		op := &InternalOperation{Type: -1, From: frame.From, Value: frame.Value}
+		if frame.To != nil {
+			op.To = *frame.To
+		}
+		switch frame.Type {
+		case "CALL":
+			if frame.Value != nil && frame.Value.ToInt().Cmp(big.NewInt(0)) > 0 {
+				op.Type = OpTransfer
+			}
+		case "CREATE":
+			op.Type = OpCreate
+		case "CREATE2":
+			op.Type = OpCreate2
+		case "SELFDESTRUCT":
+			op.Type = OpSelfDestruct
+		}
+		if op.Type >= 0 {
+			*ops = append(*ops, op)
+		}
+	}
+	for _, call := range frame.Calls {
+		internalOperations(call, depth+1, ops)
+	}
+}
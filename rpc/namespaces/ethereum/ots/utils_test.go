@@ -0,0 +1,90 @@
+package ots
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/types"
+)
+
+func TestCallFrames(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x01")
+		contract = common.HexToAddress("0x02")
+		callee   = common.HexToAddress("0x03")
+		created  = common.HexToAddress("0x04")
+		value    = (*hexutil.Big)(big.NewInt(10))
+	)
+	result := map[string]interface{}{
+		"type":  "CALL",
+		"from":  sender,
+		"to":    contract,
+		"value": value,
+		"input": "0x01",
+		"calls": []interface{}{
+			map[string]interface{}{"type": "CALL", "from": contract, "to": callee, "value": value},
+			map[string]interface{}{"type": "STATICCALL", "from": contract, "to": callee},
+			map[string]interface{}{
+				"type":  "CREATE2",
+				"from":  contract,
+				"to":    created,
+				"value": "0x0",
+				"calls": []interface{}{
+					map[string]interface{}{"type": "SELFDESTRUCT", "from": created, "to": sender, "value": "0x0"},
+				},
+			},
+			map[string]interface{}{
+				"type":  "CALL",
+				"from":  contract,
+				"to":    callee,
+				"value": value,
+				"error": "execution reverted",
+				"calls": []interface{}{
+					map[string]interface{}{"type": "CREATE", "from": callee, "to": created, "value": "0x0"},
+				},
+			},
+		},
+	}
+	var frame callFrame
+	require.NoError(t, types.DecodeTracerResult(result, &frame))
+
+	entries := []*TraceEntry{}
+	flattenCallFrame(&frame, 0, &entries)
+	require.Len(t, entries, 7)
+	require.Equal(t, &TraceEntry{Type: "CALL", Depth: 0, From: sender, To: contract, Value: value, Input: hexutil.Bytes{0x01}}, entries[0])
+	require.Equal(t, "STATICCALL", entries[2].Type)
+	require.Nil(t, entries[2].Value)
+	require.Equal(t, 2, entries[4].Depth)
+	require.Equal(t, "SELFDESTRUCT", entries[4].Type)
+
+	ops := []*InternalOperation{}
+	internalOperations(&frame, 0, &ops)
+	expOps := []*InternalOperation{
+		{Type: OpTransfer, From: contract, To: callee, Value: value},
+		{Type: OpCreate2, From: contract, To: created, Value: (*hexutil.Big)(big.NewInt(0))},
+		{Type: OpSelfDestruct, From: created, To: sender, Value: (*hexutil.Big)(big.NewInt(0))},
+	}
+	require.Len(t, ops, len(expOps))
+	for i, op := range ops {
+		require.Equal(t, expOps[i].Type, op.Type)
+		require.Equal(t, expOps[i].From, op.From)
+		require.Equal(t, expOps[i].To, op.To)
+		require.Equal(t, expOps[i].Value.String(), op.Value.String())
+	}
+}
+
+func TestTotalFees(t *testing.T) {
+	fees, err := totalFees([]map[string]interface{}{
+		{"gasUsed": hexutil.Uint64(21000), "effectiveGasPrice": (*hexutil.Big)(big.NewInt(2))},
+		{"gasUsed": hexutil.Uint64(50000), "effectiveGasPrice": (*hexutil.Big)(big.NewInt(3))},
+	})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(192000), fees)
+
+	_, err = totalFees([]map[string]interface{}{{"gasUsed": uint64(1)}})
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package personal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"time"
@@ -62,6 +63,20 @@ func (api *PrivateAccountAPI) ImportRawKey(privkey, password string) (common.Add
 	return api.backend.ImportRawKey(privkey, password)
 }
 
+// ImportKeystore decrypts a version 3 Ethereum keystore file, whose key is derived with either scrypt or PBKDF2,
+// and stores its key into the key directory as done by ImportRawKey.
+func (api *PrivateAccountAPI) ImportKeystore(keyJSON, password string) (common.Address, error) {
+	api.logger.Debug("personal_importKeystore")
+	return api.backend.ImportKeystore(keyJSON, password)
+}
+
+// ExportKeystore exports the key of the given address as a version 3 Ethereum keystore file, encrypted with the
+// given password. It is only allowed when the personal namespace requires a JWT.
+func (api *PrivateAccountAPI) ExportKeystore(address common.Address, password string) (json.RawMessage, error) {
+	api.logger.Debug("personal_exportKeystore", "address", address.String())
+	return api.backend.ExportKeystore(address, password)
+}
+
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 	api.logger.Debug("personal_listAccounts")
@@ -0,0 +1,501 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/trace")
+
+const (
+	flatCallTracer = "flatCallTracer"
+	prestateTracer = "prestateTracer"
+	muxTracer      = "muxTracer"
+)
+
+var errInvalidBlockRange = errors.New("invalid block range params")
+
+// Backend defines the methods required by the trace API.
+type Backend interface {
+	BlockNumber(ctx context.Context) (hexutil.Uint64, error)
+	CometBlockByNumber(ctx context.Context, blockNum types.BlockNumber) (*tmrpctypes.ResultBlock, error)
+	CometBlockResultByNumber(ctx context.Context, height *int64) (*tmrpctypes.ResultBlockResults, error)
+	EthMsgsFromCometBlock(ctx context.Context, block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
+	GetTxByEthHash(ctx context.Context, txHash common.Hash) (*servertypes.TxResult, error)
+	GetCode(ctx context.Context, address common.Address, blockNrOrHash types.BlockNumberOrHash) (hexutil.Bytes, error)
+	TraceTransaction(ctx context.Context, hash common.Hash, config *types.TraceConfig) (interface{}, error)
+	TraceBlock(ctx context.Context, height types.BlockNumber, config *types.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	RPCBlockRangeCap() int32
+}
+
+// API offers the OpenEthereum compatible trace namespace, built on top of
+// the EVM module tracers.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new trace API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat call traces of all the transactions of the given block.
+func (api *API) Block(blockNr types.BlockNumber) (_ []*Trace, err error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+	ctx, span := tracer.Start(context.Background(), "trace_block", trace.WithAttributes(attribute.Int64("blockNr", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := api.cometBlock(ctx, blockNr)
+	if err != nil {
+		return nil, err
+	}
+	return api.blockTraces(ctx, resBlock)
+}
+
+// Transaction returns the flat call traces of the given transaction.
+func (api *API) Transaction(hash common.Hash) (_ []*Trace, err error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "trace_transaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	txResult, resBlock, err := api.transactionBlock(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := api.backend.TraceTransaction(ctx, hash, &types.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: flatCallTracer},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*Trace
+	if err := types.DecodeTracerResult(result, &traces); err != nil {
+		return nil, err
+	}
+	setBlockInfo(traces, resBlock, hash, uint64(txResult.EthTxIndex)) //nolint:gosec // G115
+	return traces, nil
+}
+
+// Filter returns the flat call traces of the given block range, matching the
+// from and to address filters.
+func (api *API) Filter(args FilterArgs) (_ []*Trace, err error) {
+	api.logger.Debug("trace_filter", "args", args)
+	ctx, span := tracer.Start(context.Background(), "trace_filter")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	head, err := api.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := resolveBlockNumber(args.FromBlock, int64(head)) //nolint:gosec // G115
+	if err != nil {
+		return nil, err
+	}
+	to, err := resolveBlockNumber(args.ToBlock, int64(head)) //nolint:gosec // G115
+	if err != nil {
+		return nil, err
+	}
+	if from > to || to > int64(head) { //nolint:gosec // G115
+		return nil, errInvalidBlockRange
+	}
+	if blockLimit := int64(api.backend.RPCBlockRangeCap()); blockLimit > 0 && to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var (
+		after  uint64
+		result = []*Trace{}
+	)
+	if args.After != nil {
+		after = *args.After
+	}
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resBlock, err := api.cometBlock(ctx, types.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		traces, err := api.blockTraces(ctx, resBlock)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range traces {
+			if !args.matches(t) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			result = append(result, t)
+			if args.Count != nil && uint64(len(result)) >= *args.Count {
+				return result, nil
+			}
+		}
+	}
+	return result, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns the requested trace types for each of them.
+func (api *API) ReplayBlockTransactions(blockNr types.BlockNumber, traceTypes []string) (_ []*TraceResults, err error) {
+	api.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+	ctx, span := tracer.Start(context.Background(), "trace_replayBlockTransactions", trace.WithAttributes(attribute.Int64("blockNr", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := api.cometBlock(ctx, blockNr)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := api.ethMsgs(ctx, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	height := types.BlockNumber(resBlock.Block.Height)
+	muxResults, err := api.backend.TraceBlock(ctx, height, replayTraceConfig(traceTypes), resBlock)
+	if err != nil {
+		return nil, err
+	}
+	var vmResults []*evmtypes.TxTraceResult
+	if slices.Contains(traceTypes, TraceTypeVMTrace) {
+		if vmResults, err = api.backend.TraceBlock(ctx, height, vmTraceConfig(), resBlock); err != nil {
+			return nil, err
+		}
+	}
+	if len(muxResults) != len(msgs) || (vmResults != nil && len(vmResults) != len(msgs)) {
+		return nil, fmt.Errorf("unexpected number of traces for block %d", resBlock.Block.Height)
+	}
+
+	codeAt := api.codeAt(ctx, resBlock.Block.Height-1)
+	results := make([]*TraceResults, len(msgs))
+	for i, msg := range msgs {
+		if muxResults[i].Error != "" {
+			return nil, errors.New(muxResults[i].Error)
+		}
+		var vmResult interface{}
+		if vmResults != nil {
+			if vmResults[i].Error != "" {
+				return nil, errors.New(vmResults[i].Error)
+			}
+			vmResult = vmResults[i].Result
+		}
+
+		hash := msg.Hash()
+		res, err := newTraceResults(traceTypes, muxResults[i].Result, vmResult, msg, codeAt)
+		if err != nil {
+			return nil, err
+		}
+		res.TransactionHash = &hash
+		results[i] = res
+	}
+	return results, nil
+}
+
+// ReplayTransaction replays the given transaction and returns the requested
+// trace types.
+func (api *API) ReplayTransaction(hash common.Hash, traceTypes []string) (_ *TraceResults, err error) {
+	api.logger.Debug("trace_replayTransaction", "hash", hash, "types", traceTypes)
+	ctx, span := tracer.Start(context.Background(), "trace_replayTransaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+	_, resBlock, err := api.transactionBlock(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := api.ethMsgs(ctx, resBlock)
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(msgs, func(msg *evmtypes.MsgEthereumTx) bool { return msg.Hash() == hash })
+	if idx < 0 {
+		return nil, fmt.Errorf("transaction %s not found in block %d", hash, resBlock.Block.Height)
+	}
+
+	muxResult, err := api.backend.TraceTransaction(ctx, hash, replayTraceConfig(traceTypes))
+	if err != nil {
+		return nil, err
+	}
+	var vmResult interface{}
+	if slices.Contains(traceTypes, TraceTypeVMTrace) {
+		if vmResult, err = api.backend.TraceTransaction(ctx, hash, vmTraceConfig()); err != nil {
+			return nil, err
+		}
+	}
+	return newTraceResults(traceTypes, muxResult, vmResult, msgs[idx], api.codeAt(ctx, resBlock.Block.Height-1))
+}
+
+// cometBlock returns the CometBFT block of the given number.
+func (api *API) cometBlock(ctx context.Context, blockNr types.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	resBlock, err := api.backend.CometBlockByNumber(ctx, blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr.Int64())
+	}
+	return resBlock, nil
+}
+
+// transactionBlock returns the indexed result and the CometBFT block of the
+// given transaction.
+func (api *API) transactionBlock(ctx context.Context, hash common.Hash) (*servertypes.TxResult, *tmrpctypes.ResultBlock, error) {
+	txResult, err := api.backend.GetTxByEthHash(ctx, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	resBlock, err := api.cometBlock(ctx, types.BlockNumber(txResult.Height))
+	if err != nil {
+		return nil, nil, err
+	}
+	return txResult, resBlock, nil
+}
+
+// ethMsgs returns the Ethereum transactions of the given block, in the same
+// order as they are traced by the backend.
+func (api *API) ethMsgs(ctx context.Context, resBlock *tmrpctypes.ResultBlock) ([]*evmtypes.MsgEthereumTx, error) {
+	blockRes, err := api.backend.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.EthMsgsFromCometBlock(ctx, resBlock, blockRes), nil
+}
+
+// blockTraces returns the flat call traces of all the transactions of the
+// given block.
+func (api *API) blockTraces(ctx context.Context, resBlock *tmrpctypes.ResultBlock) ([]*Trace, error) {
+	msgs, err := api.ethMsgs(ctx, resBlock)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return []*Trace{}, nil
+	}
+
+	results, err := api.backend.TraceBlock(ctx, types.BlockNumber(resBlock.Block.Height), &types.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: flatCallTracer},
+	}, resBlock)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, fmt.Errorf("unexpected number of traces for block %d", resBlock.Block.Height)
+	}
+
+	traces := []*Trace{}
+	for i, res := range results {
+		if res.Error != "" {
+			return nil, errors.New(res.Error)
+		}
+		var txTraces []*Trace
+		if err := types.DecodeTracerResult(res.Result, &txTraces); err != nil {
+			return nil, err
+		}
+		setBlockInfo(txTraces, resBlock, msgs[i].Hash(), uint64(i))
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// codeAt returns a function which queries the code of an account at the
+// given height.
+func (api *API) codeAt(ctx context.Context, height int64) func(common.Address) hexutil.Bytes {
+	blockNr := types.BlockNumber(height)
+	return func(address common.Address) hexutil.Bytes {
+		code, err := api.backend.GetCode(ctx, address, types.BlockNumberOrHash{BlockNumber: &blockNr})
+		if err != nil {
+			api.logger.Debug("failed to get code", "address", address, "height", height, "error", err.Error())
+			return hexutil.Bytes{}
+		}
+		return code
+	}
+}
+
+// setBlockInfo sets the block and transaction fields of the given traces.
+func setBlockInfo(traces []*Trace, resBlock *tmrpctypes.ResultBlock, txHash common.Hash, txIndex uint64) {
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	blockNumber := uint64(resBlock.Block.Height) //nolint:gosec // G115
+	for _, t := range traces {
+		t.BlockHash = &blockHash
+		t.BlockNumber = &blockNumber
+		t.TransactionHash = &txHash
+		t.TransactionPosition = &txIndex
+	}
+}
+
+// matches returns true if the trace matches the address filters.
+func (args *FilterArgs) matches(t *Trace) bool {
+	if len(args.FromAddress) == 0 && len(args.ToAddress) == 0 {
+		return true
+	}
+
+	var action, result traceParties
+	_ = json.Unmarshal(t.Action, &action)
+	if len(t.Result) > 0 {
+		_ = json.Unmarshal(t.Result, &result)
+	}
+
+	var from, to *common.Address
+	switch t.Type {
+	case "create":
+		from, to = action.From, result.Address
+	case "suicide":
+		from, to = action.Address, action.RefundAddress
+	default:
+		from, to = action.From, action.To
+	}
+	return matchAddress(args.FromAddress, from) && matchAddress(args.ToAddress, to)
+}
+
+func matchAddress(filter []common.Address, address *common.Address) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	return address != nil && slices.Contains(filter, *address)
+}
+
+// resolveBlockNumber returns the height of the given block number, defaulting
+// to the latest block.
+func resolveBlockNumber(blockNr *types.BlockNumber, head int64) (int64, error) {
+	if blockNr == nil {
+		return head, nil
+	}
+	switch *blockNr {
+	case types.EthLatestBlockNumber, types.EthPendingBlockNumber, types.EthSafeBlockNumber, types.EthFinalizedBlockNumber:
+		return head, nil
+	case types.EthEarliestBlockNumber:
+		return 1, nil
+	default:
+		if *blockNr < 0 {
+			return 0, errors.New("negative block number")
+		}
+		// genesis is not traceable, Int64 maps it to the first block
+		return blockNr.Int64(), nil
+	}
+}
+
+func validateTraceTypes(traceTypes []string) error {
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace, TraceTypeStateDiff, TraceTypeVMTrace:
+		default:
+			return fmt.Errorf("invalid trace type %q", traceType)
+		}
+	}
+	return nil
+}
+
+// replayTraceConfig returns the configuration of the tracer which produces
+// the call traces, and the state diff when requested, in a single execution.
+func replayTraceConfig(traceTypes []string) *types.TraceConfig {
+	tracers := map[string]interface{}{flatCallTracer: struct{}{}}
+	if slices.Contains(traceTypes, TraceTypeStateDiff) {
+		tracers[prestateTracer] = map[string]bool{"diffMode": true}
+	}
+	tracerConfig, _ := json.Marshal(tracers)
+	return &types.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: muxTracer},
+		TracerConfig: tracerConfig,
+	}
+}
+
+// vmTraceConfig returns the configuration of the struct logger used to build
+// the VM traces.
+func vmTraceConfig() *types.TraceConfig {
+	return &types.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{EnableMemory: true, DisableStorage: true},
+	}
+}
+
+// newTraceResults builds the replay result of a transaction from the output
+// of the mux tracer and, when requested, of the struct logger.
+func newTraceResults(
+	traceTypes []string,
+	muxResult interface{},
+	vmResult interface{},
+	msg *evmtypes.MsgEthereumTx,
+	codeAt func(common.Address) hexutil.Bytes,
+) (*TraceResults, error) {
+	var mux struct {
+		Traces    []*Trace      `json:"flatCallTracer"`
+		StateDiff *prestateDiff `json:"prestateTracer"`
+	}
+	if err := types.DecodeTracerResult(muxResult, &mux); err != nil {
+		return nil, err
+	}
+
+	res := &TraceResults{Output: hexutil.Bytes{}}
+	if len(mux.Traces) > 0 && len(mux.Traces[0].Result) > 0 {
+		var root struct {
+			Output hexutil.Bytes `json:"output"`
+			Code   hexutil.Bytes `json:"code"`
+		}
+		if err := json.Unmarshal(mux.Traces[0].Result, &root); err != nil {
+			return nil, err
+		}
+		res.Output = root.Output
+		if mux.Traces[0].Type == "create" {
+			res.Output = root.Code
+		}
+	}
+	if slices.Contains(traceTypes, TraceTypeTrace) {
+		res.Trace = mux.Traces
+		for _, t := range res.Trace {
+			t.BlockHash, t.BlockNumber, t.TransactionHash, t.TransactionPosition = nil, nil, nil, nil
+		}
+	}
+	if slices.Contains(traceTypes, TraceTypeStateDiff) && mux.StateDiff != nil {
+		res.StateDiff = newStateDiff(mux.StateDiff)
+	}
+	if vmResult != nil {
+		var logger structLoggerResult
+		if err := types.DecodeTracerResult(vmResult, &logger); err != nil {
+			return nil, err
+		}
+		tx := msg.AsTransaction()
+		code := hexutil.Bytes(tx.Data())
+		if tx.To() != nil {
+			code = codeAt(*tx.To())
+		}
+		res.VMTrace = newVMTrace(code, logger.StructLogs, codeAt)
+	}
+	return res, nil
+}
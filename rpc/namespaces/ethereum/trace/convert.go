@@ -0,0 +1,321 @@
+package trace
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// prestateAccount is an account as returned by the prestateTracer.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Nonce   *uint64                     `json:"nonce,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// prestateDiff is the result of the prestateTracer in diff mode.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+// structLog is a single step of the struct logger.
+type structLog struct {
+	Pc      uint64   `json:"pc"`
+	Op      string   `json:"op"`
+	Gas     uint64   `json:"gas"`
+	GasCost uint64   `json:"gasCost"`
+	Depth   int      `json:"depth"`
+	Error   string   `json:"error,omitempty"`
+	Stack   []string `json:"stack"`
+	Memory  []string `json:"memory"`
+}
+
+// structLoggerResult is the result of the default struct logger.
+type structLoggerResult struct {
+	Gas         uint64        `json:"gas"`
+	Failed      bool          `json:"failed"`
+	ReturnValue hexutil.Bytes `json:"returnValue"`
+	StructLogs  []structLog   `json:"structLogs"`
+}
+
+// newStateDiff converts the result of the prestateTracer in diff mode into
+// an OpenEthereum state diff.
+func newStateDiff(diff *prestateDiff) StateDiff {
+	res := make(StateDiff, len(diff.Post))
+	for addr, post := range diff.Post {
+		pre, existed := diff.Pre[addr]
+		if !existed {
+			res[addr] = newAccountDiff("+", post)
+			continue
+		}
+		res[addr] = changedAccountDiff(pre, post)
+	}
+	// accounts which are only present in the pre state have been deleted
+	for addr, pre := range diff.Pre {
+		if _, ok := diff.Post[addr]; !ok {
+			res[addr] = newAccountDiff("-", pre)
+		}
+	}
+	return res
+}
+
+// newAccountDiff returns the diff of an account which has been created or
+// deleted, depending on the given marker.
+func newAccountDiff(marker string, account *prestateAccount) *AccountDiff {
+	diff := &AccountDiff{
+		Balance: map[string]interface{}{marker: balanceOf(account)},
+		Nonce:   map[string]interface{}{marker: nonceOf(account)},
+		Code:    map[string]interface{}{marker: account.Code},
+		Storage: make(map[common.Hash]interface{}, len(account.Storage)),
+	}
+	if account.Code == nil {
+		diff.Code = map[string]interface{}{marker: hexutil.Bytes{}}
+	}
+	for key, value := range account.Storage {
+		diff.Storage[key] = map[string]interface{}{marker: value}
+	}
+	return diff
+}
+
+// changedAccountDiff returns the diff of an account which existed before the
+// transaction. The post state only holds the fields that have been modified.
+func changedAccountDiff(pre, post *prestateAccount) *AccountDiff {
+	diff := &AccountDiff{
+		Balance: "=",
+		Nonce:   "=",
+		Code:    "=",
+		Storage: make(map[common.Hash]interface{}),
+	}
+	if post.Balance != nil {
+		diff.Balance = changed(balanceOf(pre), post.Balance)
+	}
+	if post.Nonce != nil {
+		diff.Nonce = changed(nonceOf(pre), hexutil.Uint64(*post.Nonce))
+	}
+	if post.Code != nil {
+		preCode := pre.Code
+		if preCode == nil {
+			preCode = hexutil.Bytes{}
+		}
+		diff.Code = changed(preCode, post.Code)
+	}
+	for key, value := range post.Storage {
+		diff.Storage[key] = changed(pre.Storage[key], value)
+	}
+	// slots which are missing from the post state have been cleared
+	for key, value := range pre.Storage {
+		if _, ok := post.Storage[key]; !ok {
+			diff.Storage[key] = changed(value, common.Hash{})
+		}
+	}
+	return diff
+}
+
+func changed(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": &ChangedValue{From: from, To: to}}
+}
+
+func balanceOf(account *prestateAccount) *hexutil.Big {
+	if account.Balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return account.Balance
+}
+
+func nonceOf(account *prestateAccount) hexutil.Uint64 {
+	if account.Nonce == nil {
+		return 0
+	}
+	return hexutil.Uint64(*account.Nonce)
+}
+
+// vmTraceBuilder assembles an OpenEthereum VM trace from the steps of the
+// struct logger.
+type vmTraceBuilder struct {
+	logs   []structLog
+	pos    int
+	codeAt func(common.Address) hexutil.Bytes
+}
+
+// newVMTrace returns the VM trace of a transaction executing the given code.
+func newVMTrace(code hexutil.Bytes, logs []structLog, codeAt func(common.Address) hexutil.Bytes) *VMTrace {
+	b := &vmTraceBuilder{logs: logs, codeAt: codeAt}
+	return b.build(code, 1)
+}
+
+// build returns the trace of the call frame at the given depth, starting at
+// the current position.
+func (b *vmTraceBuilder) build(code hexutil.Bytes, depth int) *VMTrace {
+	trace := &VMTrace{Code: code, Ops: []*VMOperation{}}
+	for b.pos < len(b.logs) {
+		step := b.logs[b.pos]
+		if step.Depth < depth {
+			break
+		}
+		b.pos++
+
+		op := &VMOperation{Pc: step.Pc, Cost: step.GasCost}
+		if next := b.peek(); next != nil && next.Depth > depth {
+			op.Sub = b.build(b.subCode(&step), depth+1)
+		}
+		if step.Error == "" {
+			op.Ex = b.executed(&step, depth)
+		}
+		trace.Ops = append(trace.Ops, op)
+	}
+	return trace
+}
+
+func (b *vmTraceBuilder) peek() *structLog {
+	if b.pos >= len(b.logs) {
+		return nil
+	}
+	return &b.logs[b.pos]
+}
+
+// executed returns the effects of the given step, which are computed from the
+// next step executed in the same call frame.
+func (b *vmTraceBuilder) executed(step *structLog, depth int) *VMExecutedOperation {
+	ex := &VMExecutedOperation{Push: []string{}}
+	next := b.peek()
+	if next == nil || next.Depth != depth {
+		// last step of the call frame
+		if step.Gas > step.GasCost {
+			ex.Used = step.Gas - step.GasCost
+		}
+		return ex
+	}
+
+	ex.Used = next.Gas
+	ex.Push = pushed(step.Op, next.Stack)
+	if off, size, ok := memoryWrite(step.Op, step.Stack); ok && size > 0 {
+		memory := memoryBytes(next.Memory)
+		if off < uint64(len(memory)) {
+			end := min(off+size, uint64(len(memory)))
+			ex.Mem = &MemoryDiff{Off: off, Data: memory[off:end]}
+		}
+	}
+	if step.Op == "SSTORE" && len(step.Stack) >= 2 {
+		ex.Store = &StorageChange{Key: stackPeek(step.Stack, 0), Val: stackPeek(step.Stack, 1)}
+	}
+	return ex
+}
+
+// subCode returns the code executed by the call frame created at the given step.
+func (b *vmTraceBuilder) subCode(step *structLog) hexutil.Bytes {
+	switch step.Op {
+	case "CALL", "CALLCODE", "DELEGATECALL", "STATICCALL":
+		if len(step.Stack) < 2 || b.codeAt == nil {
+			return hexutil.Bytes{}
+		}
+		return b.codeAt(common.HexToAddress(stackPeek(step.Stack, 1)))
+	case "CREATE", "CREATE2":
+		off, okOff := stackUint64(step.Stack, 1)
+		size, okSize := stackUint64(step.Stack, 2)
+		memory := memoryBytes(step.Memory)
+		if !okOff || !okSize || off+size > uint64(len(memory)) {
+			return hexutil.Bytes{}
+		}
+		return memory[off : off+size]
+	default:
+		return hexutil.Bytes{}
+	}
+}
+
+// pushed returns the stack items that have been pushed by the given opcode,
+// taken from the top of the stack after its execution.
+func pushed(op string, stack []string) []string {
+	n := pushCount(op)
+	if n > len(stack) {
+		n = len(stack)
+	}
+	return append([]string{}, stack[len(stack)-n:]...)
+}
+
+// pushCount returns the number of stack items reported as pushed by the given
+// opcode. DUP and SWAP report all the items they touch.
+func pushCount(op string) int {
+	switch {
+	case strings.HasPrefix(op, "DUP"):
+		n, err := strconv.Atoi(op[len("DUP"):])
+		if err != nil {
+			return 0
+		}
+		return n + 1
+	case strings.HasPrefix(op, "SWAP"):
+		n, err := strconv.Atoi(op[len("SWAP"):])
+		if err != nil {
+			return 0
+		}
+		return n + 1
+	case strings.HasPrefix(op, "LOG"):
+		return 0
+	}
+	switch op {
+	case "STOP", "POP", "MSTORE", "MSTORE8", "SSTORE", "TSTORE", "JUMP", "JUMPI", "JUMPDEST",
+		"CALLDATACOPY", "CODECOPY", "EXTCODECOPY", "RETURNDATACOPY", "MCOPY",
+		"RETURN", "REVERT", "INVALID", "SELFDESTRUCT":
+		return 0
+	default:
+		return 1
+	}
+}
+
+// memoryWrite returns the memory region written by the given opcode, as
+// found on the stack before its execution.
+func memoryWrite(op string, stack []string) (off, size uint64, ok bool) {
+	var offPos, sizePos int
+	switch op {
+	case "MSTORE":
+		off, ok = stackUint64(stack, 0)
+		return off, 32, ok
+	case "MSTORE8":
+		off, ok = stackUint64(stack, 0)
+		return off, 1, ok
+	case "CALLDATACOPY", "CODECOPY", "RETURNDATACOPY", "MCOPY":
+		offPos, sizePos = 0, 2
+	case "EXTCODECOPY":
+		offPos, sizePos = 1, 3
+	case "CALL", "CALLCODE":
+		offPos, sizePos = 5, 6
+	case "DELEGATECALL", "STATICCALL":
+		offPos, sizePos = 4, 5
+	default:
+		return 0, 0, false
+	}
+	off, okOff := stackUint64(stack, offPos)
+	size, okSize := stackUint64(stack, sizePos)
+	return off, size, okOff && okSize
+}
+
+// stackPeek returns the n-th item from the top of the stack.
+func stackPeek(stack []string, n int) string {
+	return stack[len(stack)-1-n]
+}
+
+func stackUint64(stack []string, n int) (uint64, bool) {
+	if n >= len(stack) {
+		return 0, false
+	}
+	value, err := hexutil.DecodeBig(stackPeek(stack, n))
+	if err != nil || !value.IsUint64() {
+		return 0, false
+	}
+	return value.Uint64(), true
+}
+
+// memoryBytes decodes the memory of a struct logger step, which is encoded as
+// hex words without prefix.
+func memoryBytes(words []string) []byte {
+	bz, err := hex.DecodeString(strings.Join(words, ""))
+	if err != nil {
+		return nil
+	}
+	return bz
+}
@@ -0,0 +1,176 @@
+package trace
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/types"
+)
+
+func TestNewStateDiff(t *testing.T) {
+	var (
+		sender  = common.HexToAddress("0x01")
+		created = common.HexToAddress("0x02")
+		deleted = common.HexToAddress("0x03")
+		slot1   = common.HexToHash("0x01")
+		slot2   = common.HexToHash("0x02")
+		nonce0  = uint64(0)
+		nonce1  = uint64(1)
+	)
+
+	diff := newStateDiff(&prestateDiff{
+		Pre: map[common.Address]*prestateAccount{
+			sender: {
+				Balance: (*hexutil.Big)(big.NewInt(100)),
+				Nonce:   &nonce0,
+				Storage: map[common.Hash]common.Hash{slot1: common.HexToHash("0x0a"), slot2: common.HexToHash("0x0b")},
+			},
+			deleted: {Balance: (*hexutil.Big)(big.NewInt(5))},
+		},
+		Post: map[common.Address]*prestateAccount{
+			sender: {
+				Balance: (*hexutil.Big)(big.NewInt(90)),
+				Nonce:   &nonce1,
+				Storage: map[common.Hash]common.Hash{slot1: common.HexToHash("0x0c")},
+			},
+			created: {Balance: (*hexutil.Big)(big.NewInt(10)), Code: hexutil.Bytes{0x60}},
+		},
+	})
+	require.Len(t, diff, 3)
+
+	bz, err := json.Marshal(diff)
+	require.NoError(t, err)
+	var res map[common.Address]map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &res))
+
+	require.Equal(t, map[string]interface{}{"*": map[string]interface{}{"from": "0x64", "to": "0x5a"}}, res[sender]["balance"])
+	require.Equal(t, map[string]interface{}{"*": map[string]interface{}{"from": "0x0", "to": "0x1"}}, res[sender]["nonce"])
+	require.Equal(t, "=", res[sender]["code"])
+	require.Equal(t, map[string]interface{}{
+		slot1.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": common.HexToHash("0x0a").Hex(), "to": common.HexToHash("0x0c").Hex()}},
+		slot2.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": common.HexToHash("0x0b").Hex(), "to": common.Hash{}.Hex()}},
+	}, res[sender]["storage"])
+
+	require.Equal(t, map[string]interface{}{"+": "0xa"}, res[created]["balance"])
+	require.Equal(t, map[string]interface{}{"+": "0x0"}, res[created]["nonce"])
+	require.Equal(t, map[string]interface{}{"+": "0x60"}, res[created]["code"])
+
+	require.Equal(t, map[string]interface{}{"-": "0x5"}, res[deleted]["balance"])
+	require.Equal(t, map[string]interface{}{"-": "0x"}, res[deleted]["code"])
+}
+
+func TestNewVMTrace(t *testing.T) {
+	callee := common.HexToAddress("0xbeef")
+	calleeCode := hexutil.Bytes{0x00}
+	word := "0000000000000000000000000000000000000000000000000000000000000001"
+
+	logs := []structLog{
+		{Pc: 0, Op: "PUSH1", Gas: 1000, GasCost: 3, Depth: 1, Stack: []string{}},
+		{Pc: 2, Op: "PUSH1", Gas: 997, GasCost: 3, Depth: 1, Stack: []string{"0x1"}},
+		{Pc: 4, Op: "MSTORE", Gas: 994, GasCost: 6, Depth: 1, Stack: []string{"0x1", "0x0"}},
+		{Pc: 5, Op: "CALL", Gas: 988, GasCost: 100, Depth: 1, Stack: []string{"0x0", "0x0", "0x0", "0x0", "0x0", "0xbeef", "0x64"}, Memory: []string{word}},
+		{Pc: 0, Op: "STOP", Gas: 80, GasCost: 0, Depth: 2, Stack: []string{}},
+		{Pc: 6, Op: "STOP", Gas: 968, GasCost: 0, Depth: 1, Stack: []string{"0x1"}, Memory: []string{word}},
+	}
+
+	codeAt := func(address common.Address) hexutil.Bytes {
+		require.Equal(t, callee, address)
+		return calleeCode
+	}
+	trace := newVMTrace(hexutil.Bytes{0x60}, logs, codeAt)
+
+	require.Equal(t, hexutil.Bytes{0x60}, trace.Code)
+	require.Len(t, trace.Ops, 5)
+
+	push := trace.Ops[0]
+	require.Equal(t, uint64(997), push.Ex.Used)
+	require.Equal(t, []string{"0x1"}, push.Ex.Push)
+	require.Nil(t, push.Sub)
+
+	mstore := trace.Ops[2]
+	require.NotNil(t, mstore.Ex.Mem)
+	require.Equal(t, uint64(0), mstore.Ex.Mem.Off)
+	require.Equal(t, hexutil.Bytes(common.LeftPadBytes([]byte{1}, 32)), mstore.Ex.Mem.Data)
+	require.Empty(t, mstore.Ex.Push)
+
+	call := trace.Ops[3]
+	require.NotNil(t, call.Sub)
+	require.Equal(t, calleeCode, call.Sub.Code)
+	require.Len(t, call.Sub.Ops, 1)
+	require.Equal(t, uint64(968), call.Ex.Used)
+	require.Equal(t, []string{"0x1"}, call.Ex.Push)
+
+	stop := trace.Ops[4]
+	require.Equal(t, uint64(968), stop.Ex.Used)
+}
+
+func TestFilterArgsMatches(t *testing.T) {
+	var (
+		from    = common.HexToAddress("0x01")
+		to      = common.HexToAddress("0x02")
+		other   = common.HexToAddress("0x03")
+		created = common.HexToAddress("0x04")
+	)
+	call := &Trace{Type: "call", Action: json.RawMessage(`{"from":"` + from.Hex() + `","to":"` + to.Hex() + `"}`)}
+	create := &Trace{
+		Type:   "create",
+		Action: json.RawMessage(`{"from":"` + from.Hex() + `"}`),
+		Result: json.RawMessage(`{"address":"` + created.Hex() + `"}`),
+	}
+
+	testCases := []struct {
+		name  string
+		args  FilterArgs
+		trace *Trace
+		match bool
+	}{
+		{"no filter", FilterArgs{}, call, true},
+		{"from match", FilterArgs{FromAddress: []common.Address{from}}, call, true},
+		{"from mismatch", FilterArgs{FromAddress: []common.Address{other}}, call, false},
+		{"to match", FilterArgs{ToAddress: []common.Address{other, to}}, call, true},
+		{"from and to mismatch", FilterArgs{FromAddress: []common.Address{from}, ToAddress: []common.Address{other}}, call, false},
+		{"create address", FilterArgs{ToAddress: []common.Address{created}}, create, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.match, tc.args.matches(tc.trace))
+		})
+	}
+}
+
+func TestResolveBlockNumber(t *testing.T) {
+	number := types.BlockNumber(5)
+	negative := types.BlockNumber(-10)
+	testCases := []struct {
+		name    string
+		blockNr *types.BlockNumber
+		exp     int64
+		expErr  bool
+	}{
+		{"nil", nil, 100, false},
+		{"latest", ptr(types.EthLatestBlockNumber), 100, false},
+		{"earliest", ptr(types.EthEarliestBlockNumber), 1, false},
+		{"number", &number, 5, false},
+		{"negative", &negative, 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			height, err := resolveBlockNumber(tc.blockNr, 100)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, height)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
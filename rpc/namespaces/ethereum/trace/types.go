@@ -0,0 +1,115 @@
+package trace
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/types"
+)
+
+// Trace types supported by trace_replayBlockTransactions and
+// trace_replayTransaction.
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+// Trace is a single flat call trace in the OpenEthereum format.
+type Trace struct {
+	Action              json.RawMessage `json:"action"`
+	BlockHash           *common.Hash    `json:"blockHash,omitempty"`
+	BlockNumber         *uint64         `json:"blockNumber,omitempty"`
+	Error               string          `json:"error,omitempty"`
+	Result              json.RawMessage `json:"result,omitempty"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *common.Hash    `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64         `json:"transactionPosition,omitempty"`
+	Type                string          `json:"type"`
+}
+
+// traceParties holds the addresses of a trace action and result which are
+// matched by trace_filter.
+type traceParties struct {
+	From          *common.Address `json:"from"`
+	To            *common.Address `json:"to"`
+	Address       *common.Address `json:"address"`
+	RefundAddress *common.Address `json:"refundAddress"`
+}
+
+// FilterArgs are the arguments of trace_filter.
+type FilterArgs struct {
+	FromBlock   *types.BlockNumber `json:"fromBlock"`
+	ToBlock     *types.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address   `json:"fromAddress"`
+	ToAddress   []common.Address   `json:"toAddress"`
+	After       *uint64            `json:"after"`
+	Count       *uint64            `json:"count"`
+}
+
+// TraceResults is the result of replaying a transaction with the requested
+// trace types.
+type TraceResults struct {
+	Output          hexutil.Bytes `json:"output"`
+	StateDiff       StateDiff     `json:"stateDiff"`
+	Trace           []*Trace      `json:"trace"`
+	VMTrace         *VMTrace      `json:"vmTrace"`
+	TransactionHash *common.Hash  `json:"transactionHash,omitempty"`
+}
+
+// StateDiff is the set of accounts modified by a transaction.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff holds the changes of the fields of an account. Each field is
+// either "=" when unchanged, or an object keyed by "+" (created), "-"
+// (deleted) or "*" (modified).
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Nonce   interface{}                 `json:"nonce"`
+	Code    interface{}                 `json:"code"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// ChangedValue holds the previous and the new value of a modified field.
+type ChangedValue struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// VMTrace is the OpenEthereum virtual machine execution trace of a call frame.
+type VMTrace struct {
+	Code hexutil.Bytes  `json:"code"`
+	Ops  []*VMOperation `json:"ops"`
+}
+
+// VMOperation is a single executed opcode, with the sub trace of the call
+// frame it creates, if any.
+type VMOperation struct {
+	Pc   uint64               `json:"pc"`
+	Cost uint64               `json:"cost"`
+	Ex   *VMExecutedOperation `json:"ex"`
+	Sub  *VMTrace             `json:"sub"`
+}
+
+// VMExecutedOperation holds the effects of an executed opcode.
+type VMExecutedOperation struct {
+	Used  uint64         `json:"used"`
+	Push  []string       `json:"push"`
+	Mem   *MemoryDiff    `json:"mem"`
+	Store *StorageChange `json:"store"`
+}
+
+// MemoryDiff is a memory region written by an opcode.
+type MemoryDiff struct {
+	Off  uint64        `json:"off"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// StorageChange is a storage slot written by an opcode.
+type StorageChange struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
@@ -0,0 +1,244 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+)
+
+// Transport is the server a call was received on, it is used to label the
+// rejected calls metrics.
+type Transport string
+
+const (
+	TransportHTTP Transport = "http"
+	TransportWS   Transport = "ws"
+
+	// defaultClass is the class of the methods without a specific limit
+	defaultClass = "default"
+	// bucketTTL is the idle time after which the buckets of a client are
+	// dropped, it must exceed the time needed to refill a bucket
+	bucketTTL = 10 * time.Minute
+)
+
+// class is a class of methods sharing a rate limit.
+type class struct {
+	pattern  string
+	limit    rate.Limit
+	burst    int
+	rejected map[Transport]*gethmetrics.Counter
+}
+
+func newClass(name, pattern string, rps float64, burst int) *class {
+	// metric names can't hold the pattern wildcard
+	name = strings.TrimSuffix(strings.TrimSuffix(name, "*"), "_")
+	return &class{
+		pattern: pattern,
+		limit:   rate.Limit(rps),
+		burst:   burst,
+		rejected: map[Transport]*gethmetrics.Counter{
+			TransportHTTP: gethmetrics.GetOrRegisterCounter("rpc/ratelimit/rejected/http/"+name, nil),
+			TransportWS:   gethmetrics.GetOrRegisterCounter("rpc/ratelimit/rejected/ws/"+name, nil),
+		},
+	}
+}
+
+// matches returns true if the method belongs to the class.
+func (c *class) matches(method string) bool {
+	if prefix, ok := strings.CutSuffix(c.pattern, "*"); ok {
+		return strings.HasPrefix(method, prefix)
+	}
+	return method == c.pattern
+}
+
+type bucketKey struct {
+	ip    string
+	class *class
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter enforces token bucket rate limits on the JSON-RPC calls of each
+// client IP, with a bucket per class of methods. A nil Limiter allows all the
+// calls.
+type Limiter struct {
+	classes           []*class
+	fallback          *class
+	trustForwardedFor bool
+	internal          *rpctypes.InternalMarker
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+// NewLimiter creates a Limiter from the rate limit configuration, it returns
+// nil if rate limiting is disabled. The requests marked by internal have
+// already been rate limited by the websocket server.
+func NewLimiter(cfg config.RateLimitConfig, internal *rpctypes.InternalMarker) *Limiter {
+	if !cfg.Enable {
+		return nil
+	}
+
+	classes := make([]*class, len(cfg.Methods))
+	for i, m := range cfg.Methods {
+		classes[i] = newClass(m.Pattern, m.Pattern, m.RequestsPerSecond, m.Burst)
+	}
+	return &Limiter{
+		classes:           classes,
+		fallback:          newClass(defaultClass, "*", cfg.RequestsPerSecond, cfg.Burst),
+		trustForwardedFor: cfg.TrustForwardedFor,
+		internal:          internal,
+		buckets:           make(map[bucketKey]*bucket),
+		lastSweep:         time.Now(),
+	}
+}
+
+// Check takes a token for each call of the JSON-RPC request or batch from the
+// buckets of the client. It returns nil if the calls are allowed, otherwise
+// the JSON-RPC error response to send back. Requests which can't be decoded
+// are allowed so that the server reports the error.
+func (l *Limiter) Check(transport Transport, ip string, body []byte) []byte {
+	if l == nil {
+		return nil
+	}
+
+	calls, batch, err := rpctypes.DecodeJSONRPCCalls(body)
+	if err != nil {
+		return nil
+	}
+
+	// group the calls by class so a batch takes its tokens at once
+	counts := make(map[*class]int)
+	for _, c := range calls {
+		counts[l.classOf(c.Method)]++
+	}
+
+	now := time.Now()
+	l.mu.Lock()
+	l.sweep(now)
+	// the tokens are only taken once all the buckets allow the calls, so that
+	// a rejected batch doesn't use up the quota of the other classes
+	var rejected *class
+	for class, n := range counts {
+		if l.bucket(ip, class, now).TokensAt(now) < float64(n) {
+			rejected = class
+			break
+		}
+	}
+	if rejected == nil {
+		for class, n := range counts {
+			l.bucket(ip, class, now).AllowN(now, n)
+		}
+	}
+	l.mu.Unlock()
+
+	if rejected == nil {
+		return nil
+	}
+	rejected.rejected[transport].Inc(int64(len(calls)))
+
+	return rpctypes.EncodeJSONRPCErrors(calls, batch, rpctypes.ErrCodeLimitExceeded, func(c rpctypes.JSONRPCCall) string {
+		return "rate limit exceeded for " + c.Method
+	})
+}
+
+// classOf returns the class of the method.
+func (l *Limiter) classOf(method string) *class {
+	for _, c := range l.classes {
+		if c.matches(method) {
+			return c
+		}
+	}
+	return l.fallback
+}
+
+// bucket returns the token bucket of the client for the class, the caller
+// must hold the lock.
+func (l *Limiter) bucket(ip string, class *class, now time.Time) *rate.Limiter {
+	key := bucketKey{ip: ip, class: class}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(class.limit, class.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter
+}
+
+// sweep drops the idle buckets, the caller must hold the lock.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < bucketTTL {
+		return
+	}
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= bucketTTL {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// ClientIP returns the IP of the client sending the request.
+func (l *Limiter) ClientIP(r *http.Request) string {
+	if l != nil && l.trustForwardedFor {
+		if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+			addrs := strings.Split(forwarded[len(forwarded)-1], ",")
+			if ip := strings.TrimSpace(addrs[len(addrs)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// Handler wraps the http handler of the JSON-RPC server to rate limit the
+// calls. Requests larger than bodyLimit are rejected, since the JSON-RPC
+// server would serve the truncated body of a chunked request.
+func (l *Limiter) Handler(next http.Handler, bodyLimit int) http.Handler {
+	if l == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the calls forwarded by the websocket server are not limited twice
+		if internal, _ := l.internal.Internal(r); internal {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := rpctypes.PeekRequestBody(r, bodyLimit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(body) > bodyLimit {
+			http.Error(w, fmt.Sprintf("content length too large (%d>%d)", len(body), bodyLimit), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if res := l.Check(TransportHTTP, l.ClientIP(r), body); res != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write(res)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
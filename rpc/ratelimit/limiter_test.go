@@ -0,0 +1,145 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+)
+
+func newTestLimiter() *Limiter {
+	return NewLimiter(config.RateLimitConfig{
+		Enable:            true,
+		RequestsPerSecond: 0.001,
+		Burst:             3,
+		Methods: []config.MethodRateLimit{
+			{Pattern: "debug_*", RequestsPerSecond: 0.001, Burst: 1},
+			{Pattern: "eth_getLogs", RequestsPerSecond: 0.001, Burst: 2},
+		},
+	}, rpctypes.NewInternalMarker())
+}
+
+// errorResponse is a JSON-RPC error response returned by the limiter.
+type errorResponse struct {
+	ID    json.RawMessage `json:"id"`
+	Error struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+func request(method string, id int) []byte {
+	bz, _ := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method})
+	return bz
+}
+
+func TestDisabledLimiter(t *testing.T) {
+	limiter := NewLimiter(config.DefaultRateLimitConfig(), nil)
+	require.Nil(t, limiter)
+	for i := 0; i < 10; i++ {
+		require.Nil(t, limiter.Check(TransportHTTP, "1.1.1.1", request("debug_traceTransaction", i)))
+	}
+}
+
+func TestCheck(t *testing.T) {
+	limiter := newTestLimiter()
+
+	// the debug class allows a single call
+	require.Nil(t, limiter.Check(TransportHTTP, "1.1.1.1", request("debug_traceTransaction", 1)))
+	res := limiter.Check(TransportWS, "1.1.1.1", request("debug_traceBlockByNumber", 2))
+	require.NotNil(t, res)
+
+	var errRes errorResponse
+	require.NoError(t, json.Unmarshal(res, &errRes))
+	require.Equal(t, json.RawMessage("2"), errRes.ID)
+	require.Equal(t, rpctypes.ErrCodeLimitExceeded, errRes.Error.Code)
+	require.Equal(t, int64(1), limiter.classes[0].rejected[TransportWS].Snapshot().Count())
+
+	// the other classes and clients have their own buckets
+	require.Nil(t, limiter.Check(TransportHTTP, "1.1.1.1", request("eth_getLogs", 3)))
+	require.Nil(t, limiter.Check(TransportHTTP, "1.1.1.1", request("eth_blockNumber", 4)))
+	require.Nil(t, limiter.Check(TransportHTTP, "2.2.2.2", request("debug_traceTransaction", 5)))
+
+	// a batch takes a token per call
+	batch := []byte("[" + string(request("eth_getLogs", 6)) + "," + string(request("eth_chainId", 7)) + "]")
+	res = limiter.Check(TransportHTTP, "1.1.1.1", batch)
+	require.Nil(t, res)
+	res = limiter.Check(TransportHTTP, "1.1.1.1", batch)
+	require.NotNil(t, res)
+	var errResps []errorResponse
+	require.NoError(t, json.Unmarshal(res, &errResps))
+	require.Len(t, errResps, 2)
+	for i, id := range []string{"6", "7"} {
+		require.Equal(t, json.RawMessage(id), errResps[i].ID)
+		require.Equal(t, rpctypes.ErrCodeLimitExceeded, errResps[i].Error.Code)
+	}
+
+	// a batch rejected by a class doesn't take the tokens of the other classes
+	limiter = newTestLimiter()
+	batch = []byte("[" + string(request("eth_chainId", 8)) + "," + string(request("debug_traceTransaction", 9)) + "," + string(request("debug_traceTransaction", 10)) + "]")
+	require.NotNil(t, limiter.Check(TransportHTTP, "3.3.3.3", batch))
+	for i := 0; i < 3; i++ {
+		require.Nil(t, limiter.Check(TransportHTTP, "3.3.3.3", request("eth_chainId", 11+i)))
+	}
+	require.NotNil(t, limiter.Check(TransportHTTP, "3.3.3.3", request("eth_chainId", 14)))
+
+	// invalid requests are left to the server
+	require.Nil(t, limiter.Check(TransportHTTP, "1.1.1.1", []byte("{")))
+}
+
+func TestHandler(t *testing.T) {
+	limiter := newTestLimiter()
+	handler := limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	}), 1024)
+
+	send := func(body []byte, internal bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+		if internal {
+			limiter.internal.Mark(req, false)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// the body is passed untouched to the server
+	body := request("debug_traceTransaction", 1)
+	rec := send(body, false)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, body, rec.Body.Bytes())
+
+	rec = send(body, false)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Contains(t, rec.Body.String(), "rate limit exceeded")
+
+	// calls forwarded by the websocket server are already rate limited
+	rec = send(body, true)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// oversized requests are rejected, as the server would run their
+	// truncated body
+	large := append(request("eth_blockNumber", 2), make([]byte, 2048)...)
+	rec = send(large, false)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestClientIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Add("X-Forwarded-For", "1.1.1.1, 2.2.2.2")
+
+	require.Equal(t, "10.0.0.1", newTestLimiter().ClientIP(req))
+	require.Equal(t, "10.0.0.1", (*Limiter)(nil).ClientIP(req))
+
+	limiter := NewLimiter(config.RateLimitConfig{Enable: true, RequestsPerSecond: 1, Burst: 1, TrustForwardedFor: true}, nil)
+	require.Equal(t, "2.2.2.2", limiter.ClientIP(req))
+}
@@ -6,13 +6,16 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/utils"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -50,7 +53,15 @@ type RPCHeader struct {
 	Hash      common.Hash
 }
 
-// RPCStream provides data streams for newHeads, logs, and pendingTransactions.
+// TxPool is the mempool feeding the streams of the full pending txs and of the
+// dropped txs.
+type TxPool interface {
+	SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
+	SubscribeDroppedTxs(ch chan<- legacypool.DroppedTxEvent) event.Subscription
+}
+
+// RPCStream provides data streams for newHeads, logs, pendingTransactions and
+// droppedTransactions.
 type RPCStream struct {
 	evtClient rpcclient.EventsClient
 	logger    log.Logger
@@ -63,6 +74,11 @@ type RPCStream struct {
 	// pendingTxStream is backed by check-tx ante handler
 	pendingTxStream *Stream[common.Hash]
 
+	// pendingFullTxStream/droppedTxStream are backed by the mempool
+	pendingFullTxStream *Stream[*ethtypes.Transaction]
+	droppedTxStream     *Stream[legacypool.DroppedTxEvent]
+	txPoolSub           event.Subscription
+
 	wg sync.WaitGroup
 }
 
@@ -72,6 +88,9 @@ func NewRPCStreams(evtClient rpcclient.EventsClient, logger log.Logger, txDecode
 		logger:          logger,
 		txDecoder:       txDecoder,
 		pendingTxStream: NewStream[common.Hash](txStreamSegmentSize, txStreamCapacity),
+
+		pendingFullTxStream: NewStream[*ethtypes.Transaction](txStreamSegmentSize, txStreamCapacity),
+		droppedTxStream:     NewStream[legacypool.DroppedTxEvent](txStreamSegmentSize, txStreamCapacity),
 	}
 }
 
@@ -103,6 +122,10 @@ func (s *RPCStream) initSubscriptions() {
 }
 
 func (s *RPCStream) Close() error {
+	if s.txPoolSub != nil {
+		s.txPoolSub.Unsubscribe()
+	}
+
 	if s.headerStream == nil {
 		// not initialized
 		return nil
@@ -124,6 +147,18 @@ func (s *RPCStream) PendingTxStream() *Stream[common.Hash] {
 	return s.pendingTxStream
 }
 
+// PendingFullTxStream returns the stream of the txs promoted to the pending
+// pool of the mempool. It stays empty unless ListenTxPool is called.
+func (s *RPCStream) PendingFullTxStream() *Stream[*ethtypes.Transaction] {
+	return s.pendingFullTxStream
+}
+
+// DroppedTxStream returns the stream of the txs dropped from the mempool. It
+// stays empty unless ListenTxPool is called.
+func (s *RPCStream) DroppedTxStream() *Stream[legacypool.DroppedTxEvent] {
+	return s.droppedTxStream
+}
+
 func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 	s.initSubscriptions()
 	return s.logStream
@@ -134,6 +169,30 @@ func (s *RPCStream) ListenPendingTx(hash common.Hash) {
 	s.PendingTxStream().Add(hash)
 }
 
+// ListenTxPool feeds the pending full tx and dropped tx streams with the
+// events of the mempool, until the stream is closed.
+func (s *RPCStream) ListenTxPool(pool TxPool) {
+	chTxs := make(chan core.NewTxsEvent, subscribBufferSize)
+	chDropped := make(chan legacypool.DroppedTxEvent, subscribBufferSize)
+	s.txPoolSub = event.JoinSubscriptions(
+		pool.SubscribeTransactions(chTxs, false),
+		pool.SubscribeDroppedTxs(chDropped),
+	)
+
+	go func() {
+		for {
+			select {
+			case ev := <-chTxs:
+				s.pendingFullTxStream.Add(ev.Txs...)
+			case ev := <-chDropped:
+				s.droppedTxStream.Add(ev)
+			case <-s.txPoolSub.Err():
+				return
+			}
+		}
+	}()
+}
+
 func (s *RPCStream) start(
 	wg *sync.WaitGroup,
 	chBlocks <-chan coretypes.ResultEvent,
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// SendBundleArgs are the arguments of eth_sendBundle.
+type SendBundleArgs struct {
+	// Txs are the signed txs of the bundle, in execution order.
+	Txs []hexutil.Bytes `json:"txs"`
+	// BlockNumber is the height of the block the bundle targets.
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	// MinTimestamp and MaxTimestamp bound the time of the block the bundle is
+	// included in, in unix seconds.
+	MinTimestamp *uint64 `json:"minTimestamp,omitempty"`
+	MaxTimestamp *uint64 `json:"maxTimestamp,omitempty"`
+}
+
+// SendBundleResult is the result of eth_sendBundle.
+type SendBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// CallBundleArgs are the arguments of eth_callBundle.
+type CallBundleArgs struct {
+	// Txs are the signed txs of the bundle, in execution order.
+	Txs []hexutil.Bytes `json:"txs"`
+	// BlockNumber is the height of the simulated block.
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	// StateBlockNumber is the block on top of which the bundle is simulated,
+	// the latest one if unset.
+	StateBlockNumber *BlockNumber `json:"stateBlockNumber,omitempty"`
+	// Timestamp is the time of the simulated block, in unix seconds.
+	Timestamp *uint64 `json:"timestamp,omitempty"`
+}
+
+// BundleTxResult is the result of a bundle tx simulated by eth_callBundle.
+type BundleTxResult struct {
+	TxHash      common.Hash     `json:"txHash"`
+	FromAddress common.Address  `json:"fromAddress"`
+	ToAddress   *common.Address `json:"toAddress"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	GasPrice    *hexutil.Big    `json:"gasPrice"`
+	Value       hexutil.Bytes   `json:"value"`
+	Error       string          `json:"error,omitempty"`
+	Revert      string          `json:"revert,omitempty"`
+}
+
+// CallBundleResult is the result of eth_callBundle.
+type CallBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+	// BundleGasPrice is the gas weighted effective gas price of the bundle txs.
+	BundleGasPrice   *hexutil.Big     `json:"bundleGasPrice"`
+	Results          []BundleTxResult `json:"results"`
+	StateBlockNumber hexutil.Uint64   `json:"stateBlockNumber"`
+	TotalGasUsed     hexutil.Uint64   `json:"totalGasUsed"`
+}
+
+// BundleCallArgs returns the simulated call of a signed bundle tx sent by
+// from.
+func BundleCallArgs(tx *ethtypes.Transaction, from common.Address) evmtypes.TransactionArgs {
+	var (
+		gas        = hexutil.Uint64(tx.Gas())
+		nonce      = hexutil.Uint64(tx.Nonce())
+		input      = hexutil.Bytes(tx.Data())
+		accessList = tx.AccessList()
+	)
+	args := evmtypes.TransactionArgs{
+		From:    &from,
+		To:      tx.To(),
+		Gas:     &gas,
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   &nonce,
+		Input:   &input,
+		ChainID: (*hexutil.Big)(tx.ChainId()),
+	}
+	if tx.Type() == ethtypes.LegacyTxType {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	} else {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &accessList
+	}
+	if tx.Type() == ethtypes.SetCodeTxType {
+		args.AuthorizationList = tx.SetCodeAuthorizations()
+	}
+	return args
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleCallArgs(t *testing.T) {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+
+	legacy := ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    3,
+		GasPrice: big.NewInt(10),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(1),
+		Data:     []byte{0x01},
+	})
+	args := BundleCallArgs(legacy, from)
+	require.Equal(t, from, *args.From)
+	require.Equal(t, to, *args.To)
+	require.Equal(t, hexutil.Uint64(3), *args.Nonce)
+	require.Equal(t, hexutil.Uint64(21_000), *args.Gas)
+	require.Equal(t, big.NewInt(10), args.GasPrice.ToInt())
+	require.Nil(t, args.MaxFeePerGas)
+	require.Equal(t, hexutil.Bytes{0x01}, *args.Input)
+
+	accessList := ethtypes.AccessList{{Address: to}}
+	dynamic := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:    big.NewInt(9001),
+		Nonce:      4,
+		GasTipCap:  big.NewInt(2),
+		GasFeeCap:  big.NewInt(20),
+		Gas:        50_000,
+		Value:      big.NewInt(0),
+		AccessList: accessList,
+	})
+	args = BundleCallArgs(dynamic, from)
+	require.Nil(t, args.To)
+	require.Nil(t, args.GasPrice)
+	require.Equal(t, big.NewInt(20), args.MaxFeePerGas.ToInt())
+	require.Equal(t, big.NewInt(2), args.MaxPriorityFeePerGas.ToInt())
+	require.Equal(t, accessList, *args.AccessList)
+	require.Equal(t, big.NewInt(9001), args.ChainID.ToInt())
+}
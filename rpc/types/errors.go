@@ -1,5 +1,41 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrCodeTxSyncTimeout is the error code returned by eth_sendRawTransactionSync
+// when the transaction is not included before the timeout, as defined by EIP-7966.
+const ErrCodeTxSyncTimeout = 4
 
 var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+
+// TxSyncTimeoutError is returned by eth_sendRawTransactionSync when the
+// transaction was submitted but not included before the timeout. The tx hash is
+// returned as error data so the client can keep polling for the receipt.
+type TxSyncTimeoutError struct {
+	Hash    common.Hash
+	Timeout time.Duration
+}
+
+func (e *TxSyncTimeoutError) Error() string {
+	return fmt.Sprintf("transaction %s was added to the mempool but wasn't included within %s", e.Hash.Hex(), e.Timeout)
+}
+
+// ErrorCode returns the JSON-RPC error code of the timeout error.
+func (e *TxSyncTimeoutError) ErrorCode() int { return ErrCodeTxSyncTimeout }
+
+// ErrorData returns the hash of the submitted transaction.
+func (e *TxSyncTimeoutError) ErrorData() interface{} { return e.Hash.Hex() }
+
+// ErrCodeLimitExceeded is the error code returned when a request exceeds a
+// limit of the server, such as the rate limit, as defined by EIP-1474.
+const ErrCodeLimitExceeded = -32005
+
+// ErrCodeUnauthorized is the error code returned when a call to a protected
+// namespace is not authenticated.
+const ErrCodeUnauthorized = -32001
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// internalHeader marks the requests forwarded by the websocket server to
+	// the http server
+	internalHeader = "X-Evm-Internal"
+	// authenticatedSuffix is appended to the token of the requests forwarded
+	// for an authenticated websocket connection
+	authenticatedSuffix = ",authenticated"
+)
+
+// JSONRPCCall holds the id and method of a JSON-RPC call, as inspected by the
+// JSON-RPC server middlewares before the request is served.
+type JSONRPCCall struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+type jsonrpcErrorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonrpcError    `json:"error"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// DecodeJSONRPCCalls decodes the calls of a JSON-RPC request or batch, the
+// boolean result is true for a batch.
+func DecodeJSONRPCCalls(body []byte) ([]JSONRPCCall, bool, error) {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var calls []JSONRPCCall
+		if err := json.Unmarshal(body, &calls); err != nil {
+			return nil, true, err
+		}
+		return calls, true, nil
+	}
+
+	var call JSONRPCCall
+	if err := json.Unmarshal(body, &call); err != nil {
+		return nil, false, err
+	}
+	return []JSONRPCCall{call}, false, nil
+}
+
+// EncodeJSONRPCErrors encodes an error response with the code and the message
+// returned by the message function for each call, as a batch if batch is true.
+func EncodeJSONRPCErrors(calls []JSONRPCCall, batch bool, code int, message func(JSONRPCCall) string) []byte {
+	responses := make([]jsonrpcErrorResponse, len(calls))
+	for i, call := range calls {
+		id := call.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		responses[i] = jsonrpcErrorResponse{
+			Version: "2.0",
+			ID:      id,
+			Error:   jsonrpcError{Code: code, Message: message(call)},
+		}
+	}
+
+	var (
+		res []byte
+		err error
+	)
+	if batch {
+		res, err = json.Marshal(responses)
+	} else {
+		res, err = json.Marshal(responses[0])
+	}
+	if err != nil {
+		// should not happen, the ids are valid json
+		panic(err)
+	}
+	return res
+}
+
+// PeekRequestBody reads up to limit+1 bytes of the request body, so oversized
+// requests can be detected, and restores the body for the next handler.
+func PeekRequestBody(r *http.Request, limit int) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	return body, nil
+}
+
+// InternalMarker marks the requests forwarded by the websocket server to the
+// http server with a random token, so that the http server middlewares can
+// tell them from the client requests. Their calls have already been rate
+// limited and authenticated by the websocket server.
+type InternalMarker struct {
+	token string
+}
+
+// NewInternalMarker creates an InternalMarker with a random token.
+func NewInternalMarker() *InternalMarker {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		panic(err)
+	}
+	return &InternalMarker{token: hex.EncodeToString(token)}
+}
+
+// Mark marks a request forwarded by the websocket server, authenticated
+// reports whether the websocket connection has been authenticated.
+func (m *InternalMarker) Mark(r *http.Request, authenticated bool) {
+	if m == nil {
+		return
+	}
+	token := m.token
+	if authenticated {
+		token += authenticatedSuffix
+	}
+	r.Header.Set(internalHeader, token)
+}
+
+// Internal reports whether the request has been forwarded by the websocket
+// server, and whether it has been forwarded for an authenticated connection.
+func (m *InternalMarker) Internal(r *http.Request) (internal, authenticated bool) {
+	if m == nil {
+		return false, false
+	}
+	token := r.Header.Get(internalHeader)
+	if token == "" {
+		return false, false
+	}
+	token, authenticated = strings.CutSuffix(token, authenticatedSuffix)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(m.token)) != 1 {
+		return false, false
+	}
+	return true, authenticated
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternalMarker(t *testing.T) {
+	marker := NewInternalMarker()
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	internal, authenticated := marker.Internal(req)
+	require.False(t, internal)
+	require.False(t, authenticated)
+
+	marker.Mark(req, false)
+	internal, authenticated = marker.Internal(req)
+	require.True(t, internal)
+	require.False(t, authenticated)
+
+	marker.Mark(req, true)
+	internal, authenticated = marker.Internal(req)
+	require.True(t, internal)
+	require.True(t, authenticated)
+
+	// the token of another marker is not accepted
+	internal, authenticated = NewInternalMarker().Internal(req)
+	require.False(t, internal)
+	require.False(t, authenticated)
+
+	// a nil marker marks nothing
+	var nilMarker *InternalMarker
+	req = httptest.NewRequest(http.MethodPost, "/", nil)
+	nilMarker.Mark(req, true)
+	internal, _ = marker.Internal(req)
+	require.False(t, internal)
+}
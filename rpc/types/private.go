@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// PrivateTxArgs are the arguments of eth_sendPrivateTransaction.
+type PrivateTxArgs struct {
+	// Tx is the signed tx.
+	Tx hexutil.Bytes `json:"tx"`
+	// MaxBlockNumber is the last block the tx can be included in.
+	MaxBlockNumber *hexutil.Uint64 `json:"maxBlockNumber,omitempty"`
+}
+
+// CancelPrivateTxArgs are the arguments of eth_cancelPrivateTransaction.
+type CancelPrivateTxArgs struct {
+	TxHash common.Hash `json:"txHash"`
+	// Signature is the EIP-191 signature of the tx hash by the sender of the
+	// tx, as returned by personal_sign, which proves the cancellation comes
+	// from the sender.
+	Signature hexutil.Bytes `json:"signature"`
+}
@@ -0,0 +1,210 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single eth_simulateV1 request.
+	MaxSimulateBlocks = 256
+	// MaxSimulateCallsPerBlock is the maximum number of calls allowed in a
+	// single simulated block.
+	MaxSimulateCallsPerBlock = 5000
+	// MaxSimulateTotalCalls is the maximum total number of calls allowed
+	// across all simulated blocks in a single request.
+	MaxSimulateTotalCalls = 10000
+	// SimulateTimestampIncrement is the default increment in seconds between
+	// the timestamps of two consecutive simulated blocks.
+	SimulateTimestampIncrement = 12
+)
+
+// JSON-RPC error codes returned by eth_simulateV1, as defined by the
+// execution-apis specification.
+const (
+	ErrCodeNonceTooHigh            = -38011
+	ErrCodeNonceTooLow             = -38010
+	ErrCodeIntrinsicGas            = -38013
+	ErrCodeInsufficientFunds       = -38014
+	ErrCodeBlockGasLimitReached    = -38015
+	ErrCodeBlockNumberInvalid      = -38020
+	ErrCodeBlockTimestampInvalid   = -38021
+	ErrCodeSenderIsNotEOA          = -38024
+	ErrCodeMaxInitCodeSizeExceeded = -38025
+	ErrCodeClientLimitExceeded     = -38026
+	ErrCodeInternalError           = -32603
+	ErrCodeInvalidParams           = -32602
+	ErrCodeVMError                 = -32015
+)
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// given block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is a set of header fields to override for a simulated block.
+type BlockOverrides struct {
+	Number        *hexutil.Big          `json:"number"`
+	Difficulty    *hexutil.Big          `json:"difficulty"` // No-op, the chain is always post-merge.
+	Time          *hexutil.Uint64       `json:"time"`
+	GasLimit      *hexutil.Uint64       `json:"gasLimit"`
+	FeeRecipient  *common.Address       `json:"feeRecipient"`
+	PrevRandao    *common.Hash          `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big          `json:"baseFeePerGas"`
+	BlobBaseFee   *hexutil.Big          `json:"blobBaseFee"`
+	BeaconRoot    *common.Hash          `json:"beaconRoot"`
+	Withdrawals   *ethtypes.Withdrawals `json:"withdrawals"`
+}
+
+// Validate returns an error if the overrides set fields which cannot be
+// honored by the EVM block context of a Cosmos chain.
+func (o *BlockOverrides) Validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.PrevRandao != nil {
+		return errors.New(`block override "prevRandao" is not supported`)
+	}
+	if o.BlobBaseFee != nil {
+		return errors.New(`block override "blobBaseFee" is not supported`)
+	}
+	if o.BeaconRoot != nil {
+		return errors.New(`block override "beaconRoot" is not supported`)
+	}
+	if o.Withdrawals != nil && len(*o.Withdrawals) > 0 {
+		return errors.New(`block override "withdrawals" is not supported`)
+	}
+	return nil
+}
+
+// SimCallError is the error of a single simulated call that failed during
+// EVM execution.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	MaxUsedGas  hexutil.Uint64  `json:"maxUsedGas"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// MarshalJSON marshals the call result, encoding missing logs as an empty
+// array instead of null.
+func (r SimCallResult) MarshalJSON() ([]byte, error) {
+	type callResultAlias SimCallResult
+	if r.Logs == nil {
+		r.Logs = []*ethtypes.Log{}
+	}
+	return json.Marshal(callResultAlias(r))
+}
+
+// SimBlockResult is the result of a simulated block, as returned by the EVM
+// module. It is converted to its JSON-RPC representation by RPCMarshalSimBlock.
+type SimBlockResult struct {
+	Header       *ethtypes.Header        `json:"header"`
+	Transactions []*ethtypes.Transaction `json:"transactions"`
+	// Senders holds the sender of each transaction, since the simulated
+	// transactions are not signed.
+	Senders []common.Address `json:"senders"`
+	Calls   []SimCallResult  `json:"calls"`
+}
+
+// RPCMarshalSimBlock converts a simulated block to the RPC output. When fullTx
+// is true the returned block contains full transaction details, otherwise it
+// will only contain transaction hashes.
+func RPCMarshalSimBlock(res *SimBlockResult, fullTx bool, config *ethparams.ChainConfig) map[string]interface{} {
+	block := ethtypes.NewBlockWithHeader(res.Header).WithBody(ethtypes.Body{Transactions: res.Transactions})
+	blockHash := block.Hash()
+
+	fields := RPCMarshalHeader(block.Header(), blockHash.Bytes())
+	fields["size"] = hexutil.Uint64(block.Size())
+
+	transactions := make([]interface{}, len(res.Transactions))
+	for i, tx := range res.Transactions {
+		if !fullTx {
+			transactions[i] = tx.Hash()
+			continue
+		}
+		rpcTx := NewRPCTransaction(tx, blockHash, block.NumberU64(), block.Time(), uint64(i), block.BaseFee(), config) //nolint:gosec // G115
+		if i < len(res.Senders) {
+			rpcTx.From = res.Senders[i]
+		}
+		transactions[i] = rpcTx
+	}
+	fields["transactions"] = transactions
+	fields["uncles"] = []common.Hash{}
+	fields["calls"] = res.Calls
+	return fields
+}
+
+// SimError is an error which aborts a whole eth_simulateV1 request. It
+// carries the JSON-RPC error code that is returned to the client.
+type SimError struct {
+	Message string
+	Code    int
+}
+
+// NewSimError creates a new SimError with the given code and message.
+func NewSimError(code int, message string) *SimError {
+	return &SimError{Message: message, Code: code}
+}
+
+func (e *SimError) Error() string { return e.Message }
+
+// ErrorCode returns the JSON-RPC error code of the simulation error.
+func (e *SimError) ErrorCode() int { return e.Code }
+
+// SimTxValidationError maps a transaction validation error to the error
+// returned by eth_simulateV1.
+func SimTxValidationError(err error) *SimError {
+	code := ErrCodeInternalError
+	switch {
+	case errors.Is(err, core.ErrNonceTooHigh):
+		code = ErrCodeNonceTooHigh
+	case errors.Is(err, core.ErrNonceTooLow):
+		code = ErrCodeNonceTooLow
+	case errors.Is(err, core.ErrSenderNoEOA):
+		code = ErrCodeSenderIsNotEOA
+	case errors.Is(err, core.ErrFeeCapVeryHigh),
+		errors.Is(err, core.ErrTipVeryHigh),
+		errors.Is(err, core.ErrTipAboveFeeCap),
+		errors.Is(err, core.ErrFeeCapTooLow):
+		code = ErrCodeInvalidParams
+	case errors.Is(err, core.ErrInsufficientFunds),
+		errors.Is(err, core.ErrInsufficientFundsForTransfer):
+		code = ErrCodeInsufficientFunds
+	case errors.Is(err, core.ErrIntrinsicGas):
+		code = ErrCodeIntrinsicGas
+	case errors.Is(err, vm.ErrMaxInitCodeSizeExceeded):
+		code = ErrCodeMaxInitCodeSizeExceeded
+	}
+	return NewSimError(code, err.Error())
+}
@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/cosmos/evm/rpc/types"
+)
+
+func TestBlockOverridesValidate(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	testCases := []struct {
+		name      string
+		overrides *rpc.BlockOverrides
+		expErr    string
+	}{
+		{"nil overrides", nil, ""},
+		{"supported overrides", &rpc.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1)), BaseFeePerGas: (*hexutil.Big)(big.NewInt(1))}, ""},
+		{"empty withdrawals", &rpc.BlockOverrides{Withdrawals: &ethtypes.Withdrawals{}}, ""},
+		{"prevRandao", &rpc.BlockOverrides{PrevRandao: &hash}, "prevRandao"},
+		{"blobBaseFee", &rpc.BlockOverrides{BlobBaseFee: (*hexutil.Big)(big.NewInt(1))}, "blobBaseFee"},
+		{"beaconRoot", &rpc.BlockOverrides{BeaconRoot: &hash}, "beaconRoot"},
+		{"withdrawals", &rpc.BlockOverrides{Withdrawals: &ethtypes.Withdrawals{{Index: 1}}}, "withdrawals"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.overrides.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestSimTxValidationError(t *testing.T) {
+	testCases := []struct {
+		err     error
+		expCode int
+	}{
+		{core.ErrNonceTooLow, rpc.ErrCodeNonceTooLow},
+		{core.ErrNonceTooHigh, rpc.ErrCodeNonceTooHigh},
+		{core.ErrSenderNoEOA, rpc.ErrCodeSenderIsNotEOA},
+		{core.ErrFeeCapTooLow, rpc.ErrCodeInvalidParams},
+		{core.ErrInsufficientFunds, rpc.ErrCodeInsufficientFunds},
+		{core.ErrIntrinsicGas, rpc.ErrCodeIntrinsicGas},
+		{fmt.Errorf("wrapped: %w", core.ErrNonceTooLow), rpc.ErrCodeNonceTooLow},
+		{fmt.Errorf("unknown"), rpc.ErrCodeInternalError},
+	}
+	for _, tc := range testCases {
+		simErr := rpc.SimTxValidationError(tc.err)
+		require.Equal(t, tc.expCode, simErr.ErrorCode(), tc.err.Error())
+		require.Equal(t, tc.err.Error(), simErr.Error())
+	}
+}
+
+func TestSimCallResultMarshalJSON(t *testing.T) {
+	bz, err := json.Marshal(rpc.SimCallResult{Status: 1})
+	require.NoError(t, err)
+
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, []interface{}{}, res["logs"])
+	require.NotContains(t, res, "error")
+}
+
+func TestRPCMarshalSimBlock(t *testing.T) {
+	to := common.HexToAddress("0x02")
+	from := common.HexToAddress("0x01")
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{To: &to, Gas: 21000, GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1), Value: big.NewInt(1)})
+	header := &ethtypes.Header{Number: big.NewInt(10), Difficulty: big.NewInt(0), BaseFee: big.NewInt(0), GasLimit: 30_000_000}
+	res := &rpc.SimBlockResult{
+		Header:       header,
+		Transactions: []*ethtypes.Transaction{tx},
+		Senders:      []common.Address{from},
+		Calls:        []rpc.SimCallResult{{Status: 1}},
+	}
+
+	fields := rpc.RPCMarshalSimBlock(res, false, ethparams.TestChainConfig)
+	require.Equal(t, []interface{}{tx.Hash()}, fields["transactions"])
+	require.Equal(t, res.Calls, fields["calls"])
+
+	fields = rpc.RPCMarshalSimBlock(res, true, ethparams.TestChainConfig)
+	txs := fields["transactions"].([]interface{})
+	require.Len(t, txs, 1)
+	require.Equal(t, from, txs[0].(*rpc.RPCTransaction).From)
+}
@@ -30,6 +30,14 @@ type AccountResult struct {
 	StorageProof []StorageResult `json:"storageProof"`
 }
 
+// AccountInfo is the state of an account returned by eth_getAccount.
+type AccountInfo struct {
+	CodeHash    common.Hash    `json:"codeHash"`
+	StorageRoot common.Hash    `json:"storageRoot"`
+	Balance     *hexutil.Big   `json:"balance"`
+	Nonce       hexutil.Uint64 `json:"nonce"`
+}
+
 // StorageResult defines the format for storage proof return
 type StorageResult struct {
 	Key   string       `json:"key"`
@@ -37,6 +45,21 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
+// StorageRangeResult is the result of a debug_storageRangeAt API call.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key of the account.
+}
+
+// StorageMap maps the hashes of the storage keys to the storage entries.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry defines a storage slot of a debug_storageRangeAt result.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash           *common.Hash                    `json:"blockHash"`
@@ -169,6 +192,14 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// DroppedTransaction represents a transaction dropped from the mempool before
+// being included in a block.
+type DroppedTransaction struct {
+	Hash       common.Hash  `json:"hash"`
+	Reason     string       `json:"reason"`
+	ReplacedBy *common.Hash `json:"replacedBy,omitempty"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee         *big.Int   // base fee for each block
 	Reward                       []*big.Int // each element of the array will have the tip provided to miners for the percentile given
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -444,6 +445,30 @@ func RPCMarshalBlock(block *ethtypes.Block, cmtBlock *cmtrpccore.ResultBlock, ms
 	return fields, nil
 }
 
+// RPCMarshalPendingBlock converts the given pending block to the RPC output which depends on fullTx. As done by
+// go-ethereum, the hash, nonce and miner of the pending block are not set, and its transactions are not located.
+func RPCMarshalPendingBlock(block *ethtypes.Block, fullTx bool, config *ethparams.ChainConfig) map[string]interface{} {
+	fields := RPCMarshalHeader(block.Header(), nil)
+	fields["hash"] = nil
+	fields["nonce"] = nil
+	fields["miner"] = nil
+	fields["size"] = hexutil.Uint64(block.Size())
+
+	txs := block.Transactions()
+	transactions := make([]interface{}, len(txs))
+	for i, tx := range txs {
+		if !fullTx {
+			transactions[i] = tx.Hash()
+			continue
+		}
+		transactions[i] = NewRPCPendingTransaction(tx, block.Header(), config)
+	}
+	fields["transactions"] = transactions
+	fields["uncles"] = []common.Hash{}
+	fields["withdrawals"] = block.Withdrawals()
+	return fields
+}
+
 // newRPCTransactionFromBlockIndex returns a transaction that will serialize to the RPC representation.
 func newRPCTransactionFromBlockIndex(b *ethtypes.Block, blockHash common.Hash, index uint64, config *ethparams.ChainConfig) *RPCTransaction {
 	txs := b.Transactions()
@@ -518,3 +543,13 @@ func EffectiveGasPrice(tx *ethtypes.Transaction, baseFee *big.Int) *big.Int {
 
 	return fee
 }
+
+// DecodeTracerResult converts a tracer result, decoded as a generic JSON value,
+// into the given type.
+func DecodeTracerResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
@@ -16,6 +16,7 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -23,10 +24,15 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	"github.com/cosmos/evm/rpc/auth"
+	rpcmetrics "github.com/cosmos/evm/rpc/metrics"
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log/v2"
 
@@ -76,10 +82,24 @@ type websocketsServer struct {
 	keyFile        string
 	allowedOrigins []string // allowed origins for WebSocket connections
 	api            *pubSubAPI
+	internal       *rpctypes.InternalMarker // marks the calls forwarded to the http server
+	limiter        *ratelimit.Limiter       // nil if rate limiting is disabled
+	auth           *auth.JWTAuth            // nil if authentication is disabled
+	metrics        *rpcmetrics.Metrics      // nil if the metrics are disabled
 	logger         log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	backend rpcfilters.Backend,
+	cfg *config.Config,
+	internal *rpctypes.InternalMarker,
+	limiter *ratelimit.Limiter,
+	jwtAuth *auth.JWTAuth,
+	rpcMetrics *rpcmetrics.Metrics,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
@@ -87,7 +107,11 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
-		api:            newPubSubAPI(clientCtx, logger, stream),
+		api:            newPubSubAPI(clientCtx, logger, stream, backend),
+		internal:       internal,
+		limiter:        limiter,
+		auth:           jwtAuth,
+		metrics:        rpcMetrics,
 		logger:         logger,
 	}
 }
@@ -195,18 +219,25 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:      new(sync.Mutex),
+		conn:     conn,
+		clientIP: s.limiter.ClientIP(r),
+		// the token is only checked on handshake, like the engine API
+		authenticated: s.auth.Authenticate(r),
 	}
 
 	s.readLoop(ws)
 }
 
+// errCodeInvalidRequest is the JSON-RPC error code of the error responses sent
+// by the websocket server.
+const errCodeInvalidRequest = -32600
+
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
 		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
+			Code:    big.NewInt(errCodeInvalidRequest),
 			Message: msg,
 		},
 		ID: nil,
@@ -215,9 +246,17 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// subscriptionConn is a connection the subscription notifications are written to.
+type subscriptionConn interface {
+	WriteJSON(v any) error
+	Close() error
+}
+
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn          *websocket.Conn
+	mux           *sync.Mutex
+	clientIP      string
+	authenticated bool
 }
 
 func (w *wsConn) WriteJSON(v any) error {
@@ -260,6 +299,22 @@ readLoop:
 			return
 		}
 
+		if res := s.limiter.Check(ratelimit.TransportWS, wsConn.clientIP, mb); res != nil {
+			if err := wsConn.WriteJSON(json.RawMessage(res)); err != nil {
+				s.logger.Error("error writing rate limit response", "error", err.Error())
+				break readLoop
+			}
+			continue
+		}
+
+		if res := s.auth.Check(wsConn.authenticated, mb); res != nil {
+			if err := wsConn.WriteJSON(json.RawMessage(res)); err != nil {
+				s.logger.Error("error writing unauthorized response", "error", err.Error())
+				break readLoop
+			}
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -301,17 +356,26 @@ readLoop:
 			continue
 		}
 
+		// the other calls are recorded by the http server they are forwarded to
+		var done func(code int)
+		if method == "eth_subscribe" || method == "eth_unsubscribe" {
+			done = s.metrics.Start(ratelimit.TransportWS, method)
+		}
+
 		switch method {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				done(errCodeInvalidRequest)
 				continue
 			}
 
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			ready := make(chan struct{})
+			unsubFn, err := s.api.subscribe(wsConn, subID, params, ready)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
+				done(errCodeInvalidRequest)
 				continue
 			}
 			subscriptions[subID] = unsubFn
@@ -322,19 +386,23 @@ readLoop:
 				Result:  subID,
 			}
 
+			done(0)
 			if err := wsConn.WriteJSON(res); err != nil {
 				s.logger.Error("error writing subscription response", "error", err.Error())
 				break readLoop
 			}
+			close(ready)
 		case "eth_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				done(errCodeInvalidRequest)
 				continue
 			}
 
 			id, ok := params[0].(string)
 			if !ok {
 				s.sendErrResponse(wsConn, "invalid parameters")
+				done(errCodeInvalidRequest)
 				continue
 			}
 
@@ -351,6 +419,7 @@ readLoop:
 				Result:  ok,
 			}
 
+			done(0)
 			if err := wsConn.WriteJSON(res); err != nil {
 				s.logger.Error("error writing unsubscribe response", "error", err.Error())
 				break readLoop
@@ -389,6 +458,7 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	s.internal.Mark(req, wsConn.authenticated)
 	client := &http.Client{}
 	// #nosec G704 -- URL is node's own rpcAddr from config, not user-controlled
 	resp, err := client.Do(req)
@@ -415,21 +485,33 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *stream.RPCStream
+	backend   rpcfilters.Backend // queries the historical logs, nil if unsupported
 	logger    log.Logger
 	clientCtx client.Context
 }
 
+// logsCatchUpResult is sent on a logs subscription with a fromBlock once the
+// historical logs up to ToBlock are sent, the following logs are live ones.
+type logsCatchUpResult struct {
+	CatchUpComplete bool           `json:"catchUpComplete"`
+	ToBlock         hexutil.Uint64 `json:"toBlock"`
+}
+
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, backend rpcfilters.Backend) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
 		events:    stream,
+		backend:   backend,
 		logger:    logger,
 		clientCtx: clientCtx,
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (context.CancelFunc, error) {
+// subscribe starts the subscription for the params. The ready channel is
+// closed once the subscription id is sent to the client, the notifications
+// which must not be sent before it wait for it.
+func (api *pubSubAPI) subscribe(wsConn subscriptionConn, subID rpc.ID, params []any, ready <-chan struct{}) (context.CancelFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -441,11 +523,22 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (con
 		return api.subscribeNewHeads(wsConn, subID)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			return api.subscribeLogs(wsConn, subID, params[1], ready)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		return api.subscribeLogs(wsConn, subID, nil, ready)
 	case "newPendingTransactions":
+		if len(params) > 1 && params[1] != nil {
+			fullTx, ok := params[1].(bool)
+			if !ok {
+				return nil, errors.New("invalid parameters: fullTx must be a boolean")
+			}
+			if fullTx {
+				return api.subscribePendingFullTransactions(wsConn, subID)
+			}
+		}
 		return api.subscribePendingTransactions(wsConn, subID)
+	case "droppedTransactions":
+		return api.subscribeDroppedTransactions(wsConn, subID)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -453,7 +546,7 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (con
 	}
 }
 
-func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+func (api *pubSubAPI) subscribeNewHeads(wsConn subscriptionConn, subID rpc.ID) (context.CancelFunc, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	//nolint: errcheck
 	go api.events.HeaderStream().Subscribe(ctx, func(headers []stream.RPCHeader, _ int) error {
@@ -502,8 +595,9 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (context.CancelFunc, error) {
+func (api *pubSubAPI) subscribeLogs(wsConn subscriptionConn, subID rpc.ID, extra any, ready <-chan struct{}) (context.CancelFunc, error) {
 	crit := filters.FilterCriteria{}
+	var fromBlock *rpc.BlockNumber
 
 	if extra != nil {
 		params, ok := extra.(map[string]any)
@@ -590,6 +684,21 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (co
 				crit.Topics[topicIdx] = subtopicsCollect
 			}
 		}
+
+		if params["fromBlock"] != nil {
+			bz, err := json.Marshal(params["fromBlock"])
+			if err != nil {
+				return nil, err
+			}
+			fromBlock = new(rpc.BlockNumber)
+			if err := fromBlock.UnmarshalJSON(bz); err != nil {
+				return nil, errors.Wrap(err, "invalid fromBlock")
+			}
+		}
+	}
+
+	if fromBlock != nil {
+		return api.subscribeLogsFrom(wsConn, subID, crit, *fromBlock, ready)
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -628,7 +737,125 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (co
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+// subscribeLogsFrom sends the historical logs matching crit from fromBlock up
+// to the latest block, then a logsCatchUpResult, then the live logs of the
+// following blocks.
+func (api *pubSubAPI) subscribeLogsFrom(
+	wsConn subscriptionConn,
+	subID rpc.ID,
+	crit filters.FilterCriteria,
+	fromBlock rpc.BlockNumber,
+	ready <-chan struct{},
+) (context.CancelFunc, error) {
+	if api.backend == nil {
+		return nil, errors.New("logs subscription from a past block is not supported")
+	}
+	if fromBlock == rpc.PendingBlockNumber {
+		return nil, errors.New("pending logs are not supported")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// the live logs are read from the start of the stream, which holds the
+	// logs of the blocks committed while the historical ones are queried,
+	// skipping the logs of the blocks already sent from the history
+	logStream := api.events.LogStream()
+	header, err := api.backend.HeaderByNumber(ctx, rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if header == nil || header.Number == nil {
+		cancel()
+		return nil, errors.New("latest block not found")
+	}
+	head := header.Number.Int64()
+
+	from := fromBlock.Int64()
+	switch fromBlock {
+	case rpc.LatestBlockNumber, rpc.SafeBlockNumber, rpc.FinalizedBlockNumber:
+		from = head
+	case rpc.EarliestBlockNumber:
+		from = 1
+	}
+
+	var history []*ethtypes.Log
+	if from <= head {
+		filter := rpcfilters.NewRangeFilter(api.logger, api.backend, from, head, crit.Addresses, crit.Topics)
+		history, err = filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	liveFrom := uint64(max(from, head+1)) //#nosec G115 -- from is positive
+
+	go func() {
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return
+		}
+
+		for _, ethLog := range history {
+			if err := api.notify(wsConn, subID, ethLog); err != nil {
+				return
+			}
+		}
+		if err := api.notify(wsConn, subID, logsCatchUpResult{CatchUpComplete: true, ToBlock: hexutil.Uint64(liveFrom - 1)}); err != nil {
+			return
+		}
+
+		var (
+			txLogs []*ethtypes.Log
+			offset = 0
+		)
+		for {
+			txLogs, offset = logStream.ReadBlocking(ctx, offset)
+			if len(txLogs) == 0 {
+				// canceled
+				return
+			}
+
+			for _, ethLog := range rpcfilters.FilterLogs(txLogs, nil, nil, crit.Addresses, crit.Topics) {
+				if ethLog.BlockNumber < liveFrom {
+					continue
+				}
+				if err := api.notify(wsConn, subID, ethLog); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return cancel, nil
+}
+
+// notify writes the subscription result to the connection, closing it if the
+// write fails.
+func (api *pubSubAPI) notify(wsConn subscriptionConn, subID rpc.ID, result any) error {
+	res := &SubscriptionNotification{
+		Jsonrpc: "2.0",
+		Method:  "eth_subscription",
+		Params: &SubscriptionResult{
+			Subscription: subID,
+			Result:       result,
+		},
+	}
+
+	err := wsConn.WriteJSON(res)
+	if err != nil {
+		api.logger.Debug("error writing subscription result, will drop peer", "error", err.Error())
+		try(func() {
+			if err != websocket.ErrCloseSent {
+				_ = wsConn.Close()
+			}
+		}, api.logger, "closing websocket peer sub")
+	}
+	return err
+}
+
+func (api *pubSubAPI) subscribePendingTransactions(wsConn subscriptionConn, subID rpc.ID) (context.CancelFunc, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	//nolint: errcheck
 	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
@@ -661,7 +888,87 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
+// subscribePendingFullTransactions sends the full body of the txs promoted to
+// the pending pool of the mempool, as geth does with the fullTx flag.
+func (api *pubSubAPI) subscribePendingFullTransactions(wsConn subscriptionConn, subID rpc.ID) (context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go api.events.PendingFullTxStream().Subscribe(ctx, func(items []*ethtypes.Transaction, _ int) error {
+		chainConfig := evmtypes.GetEthChainConfig()
+		for _, tx := range items {
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       rpctypes.NewRPCPendingTransaction(tx, nil, chainConfig),
+				},
+			}
+
+			err := wsConn.WriteJSON(res)
+			if err != nil {
+				api.logger.Debug("error writing pending tx, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return err
+			}
+		}
+		return nil
+	})
+
+	return cancel, nil
+}
+
+// subscribeDroppedTransactions sends the txs dropped from the mempool before
+// being included in a block, with the reason they were dropped.
+func (api *pubSubAPI) subscribeDroppedTransactions(wsConn subscriptionConn, subID rpc.ID) (context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go api.events.DroppedTxStream().Subscribe(ctx, func(items []legacypool.DroppedTxEvent, _ int) error {
+		for _, ev := range items {
+			dropped := rpctypes.DroppedTransaction{
+				Hash:   ev.Tx.Hash(),
+				Reason: string(ev.Reason),
+			}
+			if ev.Replacement != nil {
+				replacedBy := ev.Replacement.Hash()
+				dropped.ReplacedBy = &replacedBy
+			}
+
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       dropped,
+				},
+			}
+
+			err := wsConn.WriteJSON(res)
+			if err != nil {
+				api.logger.Debug("error writing dropped tx, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return err
+			}
+		}
+		return nil
+	})
+
+	return cancel, nil
+}
+
+func (api *pubSubAPI) subscribeSyncing(_ subscriptionConn, _ rpc.ID) (context.CancelFunc, error) {
 	return nil, errors.New("syncing subscription is not implemented")
 }
 
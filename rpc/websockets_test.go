@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -10,16 +11,24 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	filtermocks "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters/mocks"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log/v2"
 
@@ -39,7 +48,7 @@ func newTestWebsocketServer(rpcStream *stream.RPCStream) *websocketsServer {
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
-		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), rpcStream),
+		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), rpcStream, nil),
 		logger:         log.NewNopLogger(),
 		allowedOrigins: []string{"*"},
 	}
@@ -136,6 +145,136 @@ func TestSubscribeNewHeadsReturnsCanonicalBlockHash(t *testing.T) {
 	require.NotEqual(t, ethHash, gotHash, "must not regress to the eth-derived header hash")
 }
 
+// mockTxPool is a stream.TxPool feeding the streams with the events sent on
+// its feeds.
+type mockTxPool struct {
+	txFeed   event.Feed
+	dropFeed event.Feed
+}
+
+func (p *mockTxPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, _ bool) event.Subscription {
+	return p.txFeed.Subscribe(ch)
+}
+
+func (p *mockTxPool) SubscribeDroppedTxs(ch chan<- legacypool.DroppedTxEvent) event.Subscription {
+	return p.dropFeed.Subscribe(ch)
+}
+
+func TestSubscribeMempoolTransactions(t *testing.T) {
+	require.NoError(t, evmtypes.SetChainConfig(nil))
+
+	pool := &mockTxPool{}
+	rpcStream := stream.NewRPCStreams(&mockEventsClient{}, log.NewNopLogger(), nil)
+	rpcStream.ListenTxPool(pool)
+	defer rpcStream.Close()
+
+	ts := httptest.NewServer(newTestWebsocketServer(rpcStream))
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+	dialer := websocket.Dialer{}
+	conn, _, err := dialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	subscribe := func(params ...interface{}) {
+		require.NoError(t, conn.WriteJSON(map[string]interface{}{
+			"jsonrpc": "2.0", "id": 1, "method": "eth_subscribe", "params": params,
+		}))
+		var ack SubscriptionResponseJSON
+		require.NoError(t, conn.ReadJSON(&ack))
+		require.NotEmpty(t, ack.Result)
+	}
+	readResult := func() map[string]interface{} {
+		var note SubscriptionNotification
+		_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		require.NoError(t, conn.ReadJSON(&note))
+		require.NotNil(t, note.Params)
+		result, ok := note.Params.Result.(map[string]interface{})
+		require.True(t, ok)
+		return result
+	}
+
+	subscribe("newPendingTransactions", true)
+	subscribe("droppedTransactions")
+
+	to := common.HexToAddress("0x1")
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(1)})
+	pool.txFeed.Send(core.NewTxsEvent{Txs: []*ethtypes.Transaction{tx}})
+
+	result := readResult()
+	require.Equal(t, tx.Hash().Hex(), result["hash"])
+	require.Equal(t, hexutil.EncodeUint64(tx.Nonce()), result["nonce"])
+	require.Equal(t, to.Hex(), result["to"])
+
+	replacement := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(20), Gas: 21000, To: &to, Value: big.NewInt(1)})
+	pool.dropFeed.Send(legacypool.DroppedTxEvent{Tx: tx, Reason: legacypool.DropReasonReplaced, Replacement: replacement})
+
+	result = readResult()
+	require.Equal(t, tx.Hash().Hex(), result["hash"])
+	require.Equal(t, string(legacypool.DropReasonReplaced), result["reason"])
+	require.Equal(t, replacement.Hash().Hex(), result["replacedBy"])
+}
+
+func TestSubscribeLogsFromBlock(t *testing.T) {
+	rpcStream := stream.NewRPCStreams(&mockEventsClient{}, log.NewNopLogger(), nil)
+
+	newLog := func(height uint64) *ethtypes.Log {
+		return &ethtypes.Log{Address: common.HexToAddress("0x1"), BlockNumber: height, TxHash: common.BigToHash(new(big.Int).SetUint64(height))}
+	}
+	// the logs of the latest block are in the stream as well as the history,
+	// the ones of the block committed during the catch-up only in the stream
+	rpcStream.LogStream().Add(newLog(10), newLog(11))
+
+	height9, height10 := int64(9), int64(10)
+	backend := filtermocks.NewBackend(t)
+	backend.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(10)}, nil)
+	backend.EXPECT().GetLogBlocks(mock.Anything, height9, height10, mock.Anything, mock.Anything).Return([]int64{9, 10}, true, nil)
+	backend.EXPECT().GetLogsByHeight(mock.Anything, &height9).Return([][]*ethtypes.Log{{newLog(9)}}, nil)
+	backend.EXPECT().GetLogsByHeight(mock.Anything, &height10).Return([][]*ethtypes.Log{{newLog(10)}}, nil)
+	backend.EXPECT().RPCLogsCap().Return(100)
+	backend.EXPECT().RPCBlockRangeCap().Return(100)
+
+	srv := newTestWebsocketServer(rpcStream)
+	srv.api.backend = backend
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+	dialer := websocket.Dialer{}
+	conn, _, err := dialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"jsonrpc": "2.0", "id": 1, "method": "eth_subscribe", "params": []interface{}{"logs", map[string]interface{}{"fromBlock": "0x9"}},
+	}))
+	var ack SubscriptionResponseJSON
+	require.NoError(t, conn.ReadJSON(&ack))
+	require.NotEmpty(t, ack.Result)
+
+	readResult := func() map[string]interface{} {
+		var note SubscriptionNotification
+		_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		require.NoError(t, conn.ReadJSON(&note))
+		require.NotNil(t, note.Params)
+		require.Equal(t, ack.Result, string(note.Params.Subscription))
+		result, ok := note.Params.Result.(map[string]interface{})
+		require.True(t, ok)
+		return result
+	}
+
+	require.Equal(t, "0x9", readResult()["blockNumber"])
+	require.Equal(t, "0xa", readResult()["blockNumber"])
+	require.Equal(t, map[string]interface{}{"catchUpComplete": true, "toBlock": "0xa"}, readResult())
+	require.Equal(t, "0xb", readResult()["blockNumber"])
+
+	rpcStream.LogStream().Add(newLog(12))
+	require.Equal(t, "0xc", readResult()["blockNumber"])
+}
+
 func TestCheckOrigin(t *testing.T) {
 	logger := log.NewNopLogger()
 	tests := []struct {
@@ -288,3 +427,35 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestWebsocketRateLimit(t *testing.T) {
+	srv := newTestWebsocketServer(&stream.RPCStream{})
+	srv.limiter = ratelimit.NewLimiter(config.RateLimitConfig{
+		Enable:            true,
+		RequestsPerSecond: 0.001,
+		Burst:             1,
+	}, nil)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+	dialer := websocket.Dialer{}
+	conn, _, err := dialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the first call is forwarded to the http server, which is not running
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "eth_blockNumber"}))
+	var res map[string]interface{}
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Contains(t, res["error"].(map[string]interface{})["message"], "Could not perform request")
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 2, "method": "eth_blockNumber"}))
+	res = nil
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, float64(2), res["id"])
+	require.Equal(t, float64(rpctypes.ErrCodeLimitExceeded), res["error"].(map[string]interface{})["code"])
+}
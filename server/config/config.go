@@ -4,9 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"net/netip"
+	"net/url"
+	"os"
 	"path"
+	"slices"
+	"strconv"
+	stdstrings "strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 
 	cmtconfig "github.com/cometbft/cometbft/config"
@@ -50,6 +56,12 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultGraphQLAddress is the default address the GraphQL server binds to.
+	DefaultGraphQLAddress = "127.0.0.1:8547"
+
+	// DefaultIPCFileMode is the default permissions of the JSON-RPC IPC socket file.
+	DefaultIPCFileMode = "0600"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -98,6 +110,16 @@ const (
 	// DefaultTxFeeCap is the default tx-fee cap for sending a transaction
 	DefaultTxFeeCap float64 = 1.0
 
+	// DefaultTxSyncTimeout is the default time eth_sendRawTransactionSync waits for the receipt
+	DefaultTxSyncTimeout = 5 * time.Second
+
+	// DefaultTxSyncMaxTimeout is the default cap of the timeout requested to eth_sendRawTransactionSync,
+	// it is kept below DefaultHTTPTimeout so the response is not cut by the http server
+	DefaultTxSyncMaxTimeout = 20 * time.Second
+
+	// DefaultResponseCacheSize is the default number of entries of each kind held by the json-rpc response cache
+	DefaultResponseCacheSize = 1024
+
 	// DefaultHTTPTimeout is the default read/write timeout of the http json-rpc server
 	DefaultHTTPTimeout = 30 * time.Second
 
@@ -129,6 +151,9 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// DefaultAllowAddPeer toggles whether peers can be added through the `admin` namespace
+	DefaultAllowAddPeer = false
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -192,6 +217,68 @@ type MempoolConfig struct {
 	// EnableTxTracker enables per-tx lifecycle telemetry from the mempool
 	// (queued/pending/included latencies). Disabled by default.
 	EnableTxTracker bool `mapstructure:"enable-tx-tracker"`
+	// Journal is the path of the journal of the pooled transactions which
+	// survives node restarts, relative to the data directory. The journal is
+	// disabled if empty.
+	Journal string `mapstructure:"journal"`
+	// Rejournal is the time interval to regenerate the journal
+	Rejournal time.Duration `mapstructure:"rejournal"`
+	// JournalTxs selects the transactions which are journaled: all, evm or cosmos
+	JournalTxs string `mapstructure:"journal-txs"`
+	// CosmosPriceBump is the minimum fee bump percentage to replace an already
+	// existing cosmos transaction (signer sequence)
+	CosmosPriceBump uint64 `mapstructure:"cosmos-price-bump"`
+	// CosmosAccountSlots is the maximum number of cosmos transactions pooled per account
+	CosmosAccountSlots uint64 `mapstructure:"cosmos-account-slots"`
+	// Lanes split the blocks built from the mempool into lanes with their own
+	// transactions, ordering and share of the block gas, in order
+	Lanes []LaneConfig `mapstructure:"lanes"`
+}
+
+// LaneConfig defines a block building lane of the mempool. A transaction
+// belongs to the lane if it is matched by one of its msg types, contracts or
+// senders.
+type LaneConfig struct {
+	// Name identifies the lane in the logs
+	Name string `mapstructure:"name"`
+	// MsgTypes matches the transactions whose msgs all have one of the type URLs
+	MsgTypes []string `mapstructure:"msg-types"`
+	// Contracts matches the evm transactions calling one of the contracts
+	Contracts []string `mapstructure:"contracts"`
+	// Senders matches the transactions sent by one of the hex addresses
+	Senders []string `mapstructure:"senders"`
+	// Ordering orders the evm transactions of the lane: fee or arrival
+	Ordering string `mapstructure:"ordering"`
+	// MaxBlockGasShare is the max share of the block gas limit used by the
+	// lane, between 0 and 1. Zero doesn't limit the lane.
+	MaxBlockGasShare float64 `mapstructure:"max-block-gas-share"`
+}
+
+// Validate returns an error if the lane configuration is invalid
+func (c LaneConfig) Validate() error {
+	if c.Name == "" {
+		return errors.New("lane name cannot be empty")
+	}
+	if c.Name == "default" {
+		return errors.New("lane name 'default' is reserved")
+	}
+	if len(c.MsgTypes) == 0 && len(c.Contracts) == 0 && len(c.Senders) == 0 {
+		return fmt.Errorf("lane %s must match msg types, contracts or senders", c.Name)
+	}
+	for _, addr := range append(slices.Clone(c.Contracts), c.Senders...) {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("lane %s: invalid address %q", c.Name, addr)
+		}
+	}
+	switch c.Ordering {
+	case "", "fee", "arrival":
+	default:
+		return fmt.Errorf("lane %s: ordering must be fee or arrival, got %q", c.Name, c.Ordering)
+	}
+	if c.MaxBlockGasShare < 0 || c.MaxBlockGasShare > 1 {
+		return fmt.Errorf("lane %s: max block gas share must be between 0 and 1, got %f", c.Name, c.MaxBlockGasShare)
+	}
+	return nil
 }
 
 // DefaultMempoolConfig returns the default mempool configuration
@@ -209,6 +296,11 @@ func DefaultMempoolConfig() MempoolConfig {
 		CheckTxTimeout:           5 * time.Second,        // 5 seconds timeout for CheckTx handler.
 		InsertQueueSize:          5_000,                  // 5000 txs maximum in the insert queue
 		EnableTxTracker:          false,                  // tx lifecycle telemetry off by default
+		Journal:                  "mempool_journal.rlp",  // journal under the data directory
+		Rejournal:                time.Hour,              // regenerate the journal every hour
+		JournalTxs:               "all",                  // journal both the evm and cosmos txs
+		CosmosPriceBump:          10,                     // 10% fee bump to replace cosmos transaction
+		CosmosAccountSlots:       16,                     // 16 cosmos transaction slots per account
 	}
 }
 
@@ -244,6 +336,32 @@ func (c MempoolConfig) Validate() error {
 	if c.InsertQueueSize < 1 {
 		return fmt.Errorf("insert queue size must be at least 1, got %d", c.InsertQueueSize)
 	}
+	if c.CosmosPriceBump < 1 {
+		return fmt.Errorf("cosmos price bump must be at least 1, got %d", c.CosmosPriceBump)
+	}
+	if c.CosmosAccountSlots < 1 {
+		return fmt.Errorf("cosmos account slots must be at least 1, got %d", c.CosmosAccountSlots)
+	}
+	if c.Journal != "" {
+		if c.Rejournal <= 0 {
+			return fmt.Errorf("rejournal must be greater than 0, got %s", c.Rejournal)
+		}
+		switch c.JournalTxs {
+		case "all", "evm", "cosmos":
+		default:
+			return fmt.Errorf("journal txs must be one of all, evm or cosmos, got %q", c.JournalTxs)
+		}
+	}
+	lanes := make(map[string]bool, len(c.Lanes))
+	for _, lane := range c.Lanes {
+		if err := lane.Validate(); err != nil {
+			return err
+		}
+		if lanes[lane.Name] {
+			return fmt.Errorf("repeated lane %s", lane.Name)
+		}
+		lanes[lane.Name] = true
+	}
 	return nil
 }
 
@@ -255,6 +373,15 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
+	// EnableGraphQL defines if the EIP-1767 GraphQL server should be started with the JSON-RPC server
+	EnableGraphQL bool `mapstructure:"enable-graphql"`
+	// GraphQLAddress defines the GraphQL server to listen on
+	GraphQLAddress string `mapstructure:"graphql-address"`
+	// IPCPath defines the unix domain socket of the IPC server, relative to the node home if not absolute.
+	// The IPC server is disabled when it is empty.
+	IPCPath string `mapstructure:"ipc-path"`
+	// IPCFileMode defines the octal permissions of the IPC socket file
+	IPCFileMode string `mapstructure:"ipc-file-mode"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// AllowInsecureUnlock toggles if account unlocking is enabled when account-related RPCs are exposed by http.
@@ -263,6 +390,10 @@ type JSONRPCConfig struct {
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
+	// TxSyncDefaultTimeout is the time eth_sendRawTransactionSync waits for the receipt when no timeout is requested.
+	TxSyncDefaultTimeout time.Duration `mapstructure:"tx-sync-default-timeout"`
+	// TxSyncMaxTimeout is the max time eth_sendRawTransactionSync waits for the receipt.
+	TxSyncMaxTimeout time.Duration `mapstructure:"tx-sync-max-timeout"`
 	// FilterTimeout defines when an idle filter expires.
 	FilterTimeout time.Duration `mapstructure:"filter-timeout"`
 	// FilterCleanupInterval defines how often expired filters are cleaned up.
@@ -275,6 +406,9 @@ type JSONRPCConfig struct {
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// ResponseCacheSize is the number of blocks, receipts and transactions of each kind cached by the JSON-RPC
+	// backend. The cache is disabled when it is 0.
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -299,6 +433,134 @@ type JSONRPCConfig struct {
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// AllowAddPeer allows adding persistent peers with admin_addPeer
+	AllowAddPeer bool `mapstructure:"allow-add-peer"`
+	// ExternalSigner defines the HTTP URL or IPC socket path of a Clef compatible external signer.
+	// When it is set, the transactions and data are signed by the external signer instead of the keyring.
+	ExternalSigner string `mapstructure:"external-signer"`
+	// JWTSecret is the path of the file holding the hex encoded secret used to authenticate the calls to JWTNamespaces.
+	// Authentication is disabled when it is empty.
+	JWTSecret string `mapstructure:"jwt-secret"`
+	// JWTNamespaces defines the namespaces whose calls require a JWT signed with JWTSecret.
+	JWTNamespaces []string `mapstructure:"jwt-namespaces"`
+	// PrivateTxPeers defines the HTTP URLs of the JSON-RPC servers of the trusted validators the private transactions are forwarded to.
+	// The forwarded calls are authenticated with a JWT signed with JWTSecret, if set.
+	PrivateTxPeers []string `mapstructure:"private-tx-peers"`
+	// RateLimit defines the per client rate limits of the HTTP and WebSocket servers.
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+	// GasPriceOracle defines how eth_gasPrice and eth_maxPriorityFeePerGas suggest a tip from the recent blocks.
+	GasPriceOracle GasPriceOracleConfig `mapstructure:"gas-price-oracle"`
+}
+
+// GasPriceOracleConfig defines the gas price oracle suggesting the priority fee
+// from the effective tips paid in the recent blocks.
+type GasPriceOracleConfig struct {
+	// Blocks is the number of recent blocks sampled.
+	Blocks int `mapstructure:"blocks"`
+	// Percentile is the percentile of the sampled tips suggested.
+	Percentile int `mapstructure:"percentile"`
+	// MaxPrice is the maximum tip suggested, in wei.
+	MaxPrice uint64 `mapstructure:"max-price"`
+	// IgnorePrice is the tip, in wei, below which the transactions are not sampled.
+	IgnorePrice uint64 `mapstructure:"ignore-price"`
+}
+
+// DefaultGasPriceOracleConfig returns the default gas price oracle configuration,
+// which matches the geth defaults.
+func DefaultGasPriceOracleConfig() GasPriceOracleConfig {
+	return GasPriceOracleConfig{
+		Blocks:      20,
+		Percentile:  60,
+		MaxPrice:    500_000_000_000,
+		IgnorePrice: 2,
+	}
+}
+
+// Validate returns an error if the gas price oracle configuration is invalid.
+func (c GasPriceOracleConfig) Validate() error {
+	if c.Blocks < 1 {
+		return fmt.Errorf("blocks must be at least 1, got %d", c.Blocks)
+	}
+	if c.Percentile < 0 || c.Percentile > 100 {
+		return fmt.Errorf("percentile must be between 0 and 100, got %d", c.Percentile)
+	}
+	if c.MaxPrice < c.IgnorePrice {
+		return fmt.Errorf("max price %d must not be lower than the ignore price %d", c.MaxPrice, c.IgnorePrice)
+	}
+	return nil
+}
+
+// RateLimitConfig defines the token bucket rate limits applied to the JSON-RPC
+// calls of each client IP.
+type RateLimitConfig struct {
+	// Enable defines if the calls are rate limited.
+	Enable bool `mapstructure:"enable"`
+	// RequestsPerSecond is the rate at which a client can call the methods without a specific limit.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+	// Burst is the number of calls a client can make at once to the methods without a specific limit.
+	Burst int `mapstructure:"burst"`
+	// TrustForwardedFor uses the last address of the X-Forwarded-For header as client IP.
+	// It should only be enabled when the server is behind a trusted reverse proxy.
+	TrustForwardedFor bool `mapstructure:"trust-forwarded-for"`
+	// Methods defines stricter limits for classes of methods, the first matching class applies.
+	Methods []MethodRateLimit `mapstructure:"methods"`
+}
+
+// MethodRateLimit defines the rate limit of a class of JSON-RPC methods.
+type MethodRateLimit struct {
+	// Pattern is a method name, or a prefix ending with '*' such as 'debug_*'.
+	Pattern string `mapstructure:"pattern"`
+	// RequestsPerSecond is the rate at which a client can call the methods of the class.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+	// Burst is the number of calls a client can make at once to the methods of the class.
+	Burst int `mapstructure:"burst"`
+}
+
+// DefaultRateLimitConfig returns the default rate limit configuration, which is disabled.
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Enable:            false,
+		RequestsPerSecond: 50,
+		Burst:             100,
+		TrustForwardedFor: false,
+		Methods: []MethodRateLimit{
+			{Pattern: "debug_*", RequestsPerSecond: 1, Burst: 5},
+			{Pattern: "trace_*", RequestsPerSecond: 1, Burst: 5},
+			{Pattern: "eth_getLogs", RequestsPerSecond: 5, Burst: 20},
+		},
+	}
+}
+
+// Validate returns an error if the rate limit configuration is invalid.
+func (c RateLimitConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.RequestsPerSecond <= 0 {
+		return fmt.Errorf("requests per second must be greater than 0, got %f", c.RequestsPerSecond)
+	}
+	if c.Burst < 1 {
+		return fmt.Errorf("burst must be at least 1, got %d", c.Burst)
+	}
+
+	seenPatterns := make(map[string]bool)
+	for _, m := range c.Methods {
+		if m.Pattern == "" || stdstrings.Contains(stdstrings.TrimSuffix(m.Pattern, "*"), "*") {
+			return fmt.Errorf("invalid method pattern '%s'", m.Pattern)
+		}
+		if seenPatterns[m.Pattern] {
+			return fmt.Errorf("repeated method pattern '%s'", m.Pattern)
+		}
+		seenPatterns[m.Pattern] = true
+
+		if m.RequestsPerSecond <= 0 {
+			return fmt.Errorf("requests per second of '%s' must be greater than 0, got %f", m.Pattern, m.RequestsPerSecond)
+		}
+		if m.Burst < 1 {
+			return fmt.Errorf("burst of '%s' must be at least 1, got %d", m.Pattern, m.Burst)
+		}
+	}
+	return nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -346,7 +608,12 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots", "admin"}
+}
+
+// GetDefaultJWTNamespaces returns the default list of JSON-RPC namespaces protected by JWT authentication.
+func GetDefaultJWTNamespaces() []string {
+	return []string{"debug", "personal", "miner", "txpool", "admin"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -361,15 +628,22 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		API:                   GetDefaultAPINamespaces(),
 		Address:               DefaultJSONRPCAddress,
 		WsAddress:             DefaultJSONRPCWsAddress,
+		EnableGraphQL:         false,
+		GraphQLAddress:        DefaultGraphQLAddress,
+		IPCPath:               "",
+		IPCFileMode:           DefaultIPCFileMode,
 		GasCap:                DefaultGasCap,
 		AllowInsecureUnlock:   DefaultJSONRPCAllowInsecureUnlock,
 		EVMTimeout:            DefaultEVMTimeout,
 		TxFeeCap:              DefaultTxFeeCap,
+		TxSyncDefaultTimeout:  DefaultTxSyncTimeout,
+		TxSyncMaxTimeout:      DefaultTxSyncMaxTimeout,
 		FilterTimeout:         DefaultFilterTimeout,
 		FilterCleanupInterval: DefaultFilterCleanupInterval,
 		FeeHistoryCap:         DefaultFeeHistoryCap,
 		BlockRangeCap:         DefaultBlockRangeCap,
 		LogsCap:               DefaultLogsCap,
+		ResponseCacheSize:     DefaultResponseCacheSize,
 		HTTPTimeout:           DefaultHTTPTimeout,
 		HTTPIdleTimeout:       DefaultHTTPIdleTimeout,
 		HTTPBodyLimit:         DefaultHTTPBodyLimit,
@@ -381,6 +655,13 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		MetricsAddress:        DefaultJSONRPCMetricsAddress,
 		WSOrigins:             GetDefaultWSOrigins(),
 		EnableProfiling:       DefaultEnableProfiling,
+		AllowAddPeer:          DefaultAllowAddPeer,
+		ExternalSigner:        "",
+		JWTSecret:             "",
+		JWTNamespaces:         GetDefaultJWTNamespaces(),
+		PrivateTxPeers:        []string{},
+		RateLimit:             DefaultRateLimitConfig(),
+		GasPriceOracle:        DefaultGasPriceOracleConfig(),
 	}
 }
 
@@ -390,6 +671,22 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("cannot enable JSON-RPC without defining any API namespace")
 	}
 
+	if c.EnableGraphQL && c.GraphQLAddress == "" {
+		return errors.New("cannot enable GraphQL without defining its address")
+	}
+
+	if c.IPCPath != "" {
+		if _, err := ParseIPCFileMode(c.IPCFileMode); err != nil {
+			return err
+		}
+	}
+
+	if c.ExternalSigner != "" {
+		if err := ValidateExternalSigner(c.ExternalSigner); err != nil {
+			return err
+		}
+	}
+
 	if c.FilterTimeout < 0 {
 		return errors.New("JSON-RPC filter-timeout cannot be negative")
 	}
@@ -410,6 +707,18 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC EVM timeout duration cannot be negative")
 	}
 
+	if c.TxSyncDefaultTimeout < 0 || c.TxSyncMaxTimeout < 0 {
+		return errors.New("JSON-RPC tx sync timeout duration cannot be negative")
+	}
+
+	if c.TxSyncMaxTimeout == 0 {
+		return errors.New("JSON-RPC tx sync max timeout cannot be zero")
+	}
+
+	if c.TxSyncDefaultTimeout > c.TxSyncMaxTimeout {
+		return errors.New("JSON-RPC tx sync default timeout cannot be greater than the max timeout")
+	}
+
 	if c.LogsCap < 0 {
 		return errors.New("JSON-RPC logs cap cannot be negative")
 	}
@@ -418,6 +727,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -438,6 +751,27 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	for _, namespace := range c.JWTNamespaces {
+		if !strings.StringInSlice(namespace, GetAPINamespaces()) {
+			return fmt.Errorf("unknown JWT namespace '%s'", namespace)
+		}
+	}
+
+	for _, peer := range c.PrivateTxPeers {
+		u, err := url.Parse(peer)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid private tx peer '%s', expected an HTTP URL", peer)
+		}
+	}
+
+	if err := c.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC rate limit config: %w", err)
+	}
+
+	if err := c.GasPriceOracle.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC gas price oracle config: %w", err)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -451,6 +785,15 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// ParseIPCFileMode returns the permissions of the IPC socket file from their octal representation.
+func ParseIPCFileMode(mode string) (os.FileMode, error) {
+	bits, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || bits > 0o777 {
+		return 0, fmt.Errorf("invalid IPC file mode '%s', expected octal permissions such as %s", mode, DefaultIPCFileMode)
+	}
+	return os.FileMode(bits), nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -562,3 +905,22 @@ func ValidateCrossConfig(cometBFT *cmtconfig.Config, app *Config) error {
 
 	return nil
 }
+
+// ValidateExternalSigner returns an error if the external signer endpoint is neither an HTTP URL nor an IPC socket path.
+func ValidateExternalSigner(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid external signer '%s': %w", endpoint, err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return fmt.Errorf("invalid external signer '%s', missing host", endpoint)
+		}
+	case "":
+		// IPC socket path
+	default:
+		return fmt.Errorf("invalid external signer '%s', expected an HTTP URL or an IPC socket path", endpoint)
+	}
+	return nil
+}
@@ -60,6 +60,277 @@ func TestJSONRPCConfigValidate_FilterProtectionFields(t *testing.T) {
 	}
 }
 
+func TestJSONRPCConfigValidate_TxSyncTimeouts(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *serverconfig.JSONRPCConfig)
+		errText string
+	}{
+		{
+			name: "negative max timeout",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.TxSyncMaxTimeout = -1
+			},
+			errText: "tx sync timeout duration cannot be negative",
+		},
+		{
+			name: "zero max timeout",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.TxSyncMaxTimeout = 0
+			},
+			errText: "tx sync max timeout cannot be zero",
+		},
+		{
+			name: "default timeout greater than max timeout",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.TxSyncDefaultTimeout = c.TxSyncMaxTimeout + time.Second
+			},
+			errText: "tx sync default timeout cannot be greater than the max timeout",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := *serverconfig.DefaultJSONRPCConfig()
+			tc.mutate(&cfg)
+
+			err := cfg.Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errText)
+		})
+	}
+}
+
+func TestJSONRPCConfigValidate_RateLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *serverconfig.JSONRPCConfig)
+		errText string
+	}{
+		{
+			name: "without burst",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.RateLimit.Enable = true
+				c.RateLimit.Burst = 0
+			},
+			errText: "burst must be at least 1",
+		},
+		{
+			name: "invalid method pattern",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.RateLimit.Enable = true
+				c.RateLimit.Methods = append(c.RateLimit.Methods, serverconfig.MethodRateLimit{Pattern: "*_call", RequestsPerSecond: 1, Burst: 1})
+			},
+			errText: "invalid method pattern '*_call'",
+		},
+		{
+			name: "gas price oracle percentile out of range",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.GasPriceOracle.Percentile = 101
+			},
+			errText: "percentile must be between 0 and 100",
+		},
+		{
+			name: "gas price oracle without blocks",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.GasPriceOracle.Blocks = 0
+			},
+			errText: "blocks must be at least 1",
+		},
+		{
+			name: "unknown JWT namespace",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.JWTNamespaces = append(c.JWTNamespaces, "engine")
+			},
+			errText: "unknown JWT namespace 'engine'",
+		},
+		{
+			name: "private tx peer without http scheme",
+			mutate: func(c *serverconfig.JSONRPCConfig) {
+				c.PrivateTxPeers = append(c.PrivateTxPeers, "ws://validator:8546")
+			},
+			errText: "invalid private tx peer 'ws://validator:8546'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := *serverconfig.DefaultJSONRPCConfig()
+			require.NoError(t, cfg.Validate())
+			tc.mutate(&cfg)
+
+			err := cfg.Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errText)
+		})
+	}
+}
+
+func TestJSONRPCConfigValidate_ExternalSigner(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		expErr   bool
+	}{
+		{"http url", "http://localhost:8550", false},
+		{"https url", "https://signer.example.com", false},
+		{"ipc path", "/var/run/clef/clef.ipc", false},
+		{"websocket url", "ws://localhost:8550", true},
+		{"http url without host", "http://", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := *serverconfig.DefaultJSONRPCConfig()
+			cfg.ExternalSigner = tc.endpoint
+
+			err := cfg.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid external signer")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMempoolConfigValidate_Journal(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *serverconfig.MempoolConfig)
+		errText string
+	}{
+		{
+			name: "unknown journal txs",
+			mutate: func(c *serverconfig.MempoolConfig) {
+				c.JournalTxs = "local"
+			},
+			errText: "journal txs must be one of all, evm or cosmos",
+		},
+		{
+			name: "zero rejournal",
+			mutate: func(c *serverconfig.MempoolConfig) {
+				c.Rejournal = 0
+			},
+			errText: "rejournal must be greater than 0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultMempoolConfig()
+			require.NoError(t, cfg.Validate())
+			tc.mutate(&cfg)
+
+			err := cfg.Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errText)
+
+			// the journal settings are ignored once it is disabled
+			cfg.Journal = ""
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestMempoolConfigValidate_CosmosPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *serverconfig.MempoolConfig)
+		errText string
+	}{
+		{
+			name: "zero cosmos price bump",
+			mutate: func(c *serverconfig.MempoolConfig) {
+				c.CosmosPriceBump = 0
+			},
+			errText: "cosmos price bump must be at least 1",
+		},
+		{
+			name: "zero cosmos account slots",
+			mutate: func(c *serverconfig.MempoolConfig) {
+				c.CosmosAccountSlots = 0
+			},
+			errText: "cosmos account slots must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultMempoolConfig()
+			require.NoError(t, cfg.Validate())
+			tc.mutate(&cfg)
+
+			err := cfg.Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errText)
+		})
+	}
+}
+
+func TestMempoolConfigValidate_Lanes(t *testing.T) {
+	lane := serverconfig.LaneConfig{
+		Name:             "system",
+		Contracts:        []string{"0x0000000000000000000000000000000000000900"},
+		Ordering:         "arrival",
+		MaxBlockGasShare: 0.1,
+	}
+	tests := []struct {
+		name    string
+		mutate  func(c *serverconfig.LaneConfig)
+		errText string
+	}{
+		{
+			name:    "empty name",
+			mutate:  func(c *serverconfig.LaneConfig) { c.Name = "" },
+			errText: "lane name cannot be empty",
+		},
+		{
+			name:    "reserved name",
+			mutate:  func(c *serverconfig.LaneConfig) { c.Name = "default" },
+			errText: "lane name 'default' is reserved",
+		},
+		{
+			name:    "no matcher",
+			mutate:  func(c *serverconfig.LaneConfig) { c.Contracts = nil },
+			errText: "must match msg types, contracts or senders",
+		},
+		{
+			name:    "invalid sender",
+			mutate:  func(c *serverconfig.LaneConfig) { c.Senders = []string{"cosmos1"} },
+			errText: "invalid address",
+		},
+		{
+			name:    "unknown ordering",
+			mutate:  func(c *serverconfig.LaneConfig) { c.Ordering = "tip" },
+			errText: "ordering must be fee or arrival",
+		},
+		{
+			name:    "gas share above 1",
+			mutate:  func(c *serverconfig.LaneConfig) { c.MaxBlockGasShare = 1.5 },
+			errText: "max block gas share must be between 0 and 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultMempoolConfig()
+			cfg.Lanes = []serverconfig.LaneConfig{lane}
+			require.NoError(t, cfg.Validate())
+			tc.mutate(&cfg.Lanes[0])
+
+			err := cfg.Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errText)
+		})
+	}
+
+	cfg := serverconfig.DefaultMempoolConfig()
+	cfg.Lanes = []serverconfig.LaneConfig{lane, lane}
+	require.ErrorContains(t, cfg.Validate(), "repeated lane system")
+}
+
 func TestGetConfig(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -70,6 +70,46 @@ insert-queue-size = "{{ .EVM.Mempool.InsertQueueSize }}"
 # (queued/pending/included latencies). Disabled by default.
 enable-tx-tracker = {{ .EVM.Mempool.EnableTxTracker }}
 
+# Journal is the path of the journal of the pooled transactions, relative to the
+# data directory, so that they survive node restarts. The journaled transactions
+# are rechecked on startup and the invalid ones are dropped. Empty disables the journal.
+journal = "{{ .EVM.Mempool.Journal }}"
+
+# Rejournal is the time interval to regenerate the journal from the pooled transactions
+rejournal = "{{ .EVM.Mempool.Rejournal }}"
+
+# JournalTxs selects the transactions which are journaled: all, evm or cosmos
+journal-txs = "{{ .EVM.Mempool.JournalTxs }}"
+
+# CosmosPriceBump is the minimum fee bump percentage to replace an already existing cosmos transaction (signer sequence)
+cosmos-price-bump = {{ .EVM.Mempool.CosmosPriceBump }}
+
+# CosmosAccountSlots is the maximum number of cosmos transactions pooled per account. Once the
+# cosmos pool is full, new transactions evict the pooled ones paying the lowest fee.
+cosmos-account-slots = {{ .EVM.Mempool.CosmosAccountSlots }}
+
+# Lanes split the blocks built from the mempool into lanes, included in order, each up to its share of
+# the block gas limit, followed by the transactions which match no lane ordered by fee. A transaction
+# belongs to a lane if it matches one of its msg-types (all its msgs), contracts (evm transactions) or
+# senders. The ordering of the evm transactions of a lane is fee or arrival (first come, first served).
+# The lanes don't exempt their transactions from the min tip, base fee and ante handler fee checks.
+#
+# [[evm.mempool.lanes]]
+# name = "system"
+# contracts = ["0x0000000000000000000000000000000000000900"]
+# ordering = "arrival"
+# max-block-gas-share = 0.1
+{{- range .EVM.Mempool.Lanes }}
+
+[[evm.mempool.lanes]]
+name = "{{ .Name }}"
+msg-types = [{{range $index, $elmt := .MsgTypes}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+contracts = [{{range $index, $elmt := .Contracts}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+senders = [{{range $index, $elmt := .Senders}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+ordering = "{{ .Ordering }}"
+max-block-gas-share = {{ .MaxBlockGasShare }}
+{{- end }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -85,6 +125,20 @@ address = "{{ .JSONRPC.Address }}"
 # Address defines the EVM WebSocket server address to bind to.
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
+# EnableGraphQL defines if the EIP-1767 GraphQL server should be started with the JSON-RPC server.
+enable-graphql = {{ .JSONRPC.EnableGraphQL }}
+
+# GraphQLAddress defines the GraphQL server address to bind to.
+graphql-address = "{{ .JSONRPC.GraphQLAddress }}"
+
+# IPCPath defines the unix domain socket of the IPC server, relative to the node home if not absolute.
+# The IPC server serves the same namespaces as the HTTP server and supports subscriptions.
+# It is disabled when empty.
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
+# IPCFileMode defines the octal permissions of the IPC socket file.
+ipc-file-mode = "{{ .JSONRPC.IPCFileMode }}"
+
 # WSOrigins defines the allowed origins for WebSocket connections.
 # Example: ["localhost", "127.0.0.1", "myapp.example.com"]
 ws-origins = [{{range $index, $elmt := .JSONRPC.WSOrigins}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
@@ -105,6 +159,14 @@ evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 # TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
+# TxSyncDefaultTimeout is the time eth_sendRawTransactionSync waits for the receipt when the request
+# doesn't set a timeout.
+tx-sync-default-timeout = "{{ .JSONRPC.TxSyncDefaultTimeout }}"
+
+# TxSyncMaxTimeout caps the time eth_sendRawTransactionSync waits for the receipt. It can't be zero
+# and should be lower than http-timeout.
+tx-sync-max-timeout = "{{ .JSONRPC.TxSyncMaxTimeout }}"
+
 # FilterTimeout defines when an idle filter expires.
 # Filters are reclaimed via this idle sweep (matching upstream go-ethereum);
 # front the JSON-RPC server with a reverse proxy if you need rate limiting.
@@ -120,8 +182,13 @@ feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 logs-cap = {{ .JSONRPC.LogsCap }}
 
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
+# The ranges whose logs are indexed by the EVM tx indexer can be up to 10 times larger.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
+# ResponseCacheSize is the number of blocks, receipts and transactions of each kind cached in memory
+# to serve the historical queries. Set it to 0 to disable the cache.
+response-cache-size = {{ .JSONRPC.ResponseCacheSize }}
+
 # HTTPTimeout is the read/write timeout of http json-rpc server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
@@ -144,6 +211,8 @@ enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
+# The requests, latency, errors by code and in-flight calls of each JSON-RPC method are
+# exported under rpc_method_<transport>_<method>_.
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 
 # Maximum number of requests in a batch.
@@ -155,6 +224,73 @@ batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
 # Enabled profiling in the debug namespace
 enable-profiling = {{ .JSONRPC.EnableProfiling }}
 
+# Allow adding persistent peers with admin_addPeer
+allow-add-peer = {{ .JSONRPC.AllowAddPeer }}
+
+# ExternalSigner defines the HTTP URL (e.g. http://localhost:8550) or IPC socket path of a Clef compatible
+# external signer. When it is set, eth_sendTransaction, eth_signTransaction, eth_sign and eth_signTypedData
+# are signed by the external signer and the keys of the node's keyring are not used.
+external-signer = "{{ .JSONRPC.ExternalSigner }}"
+
+# JWTSecret is the path of the file holding the hex encoded 32 bytes secret used to authenticate
+# the calls to the jwt-namespaces, with HS256 tokens as done by the engine API. The token is
+# passed in the Authorization header of the HTTP requests and of the WebSocket handshake.
+# Authentication is disabled when it is empty.
+jwt-secret = "{{ .JSONRPC.JWTSecret }}"
+
+# JWTNamespaces defines the namespaces whose calls require authentication when jwt-secret is set.
+jwt-namespaces = [{{range $index, $elmt := .JSONRPC.JWTNamespaces}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# PrivateTxPeers defines the HTTP URLs of the JSON-RPC servers of the trusted validators the transactions
+# submitted with eth_sendPrivateTransaction are forwarded to, along with their cancellations. The private
+# transactions are not broadcast to the network, and are only included in the blocks proposed by this
+# node and the trusted validators. The forwarded calls carry a JWT signed with jwt-secret when it is set:
+# the peers should share the secret and only expose these endpoints to authenticated callers.
+private-tx-peers = [{{range $index, $elmt := .JSONRPC.PrivateTxPeers}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# Per client IP token bucket rate limits, applied to the HTTP and WebSocket servers.
+# Rejected calls get the JSON-RPC error code -32005.
+[json-rpc.rate-limit]
+
+# Enable defines if the calls are rate limited.
+enable = {{ .JSONRPC.RateLimit.Enable }}
+
+# RequestsPerSecond is the rate at which a client can call the methods without a specific limit.
+requests-per-second = {{ .JSONRPC.RateLimit.RequestsPerSecond }}
+
+# Burst is the number of calls a client can make at once to the methods without a specific limit.
+burst = {{ .JSONRPC.RateLimit.Burst }}
+
+# TrustForwardedFor uses the last address of the X-Forwarded-For header as client IP.
+# Only enable it when the server is behind a trusted reverse proxy.
+trust-forwarded-for = {{ .JSONRPC.RateLimit.TrustForwardedFor }}
+
+# Stricter limits for classes of methods, matched by method name or by a prefix ending with '*'.
+# The first matching class applies, each class has its own bucket per client.
+{{- range .JSONRPC.RateLimit.Methods }}
+
+[[json-rpc.rate-limit.methods]]
+pattern = "{{ .Pattern }}"
+requests-per-second = {{ .RequestsPerSecond }}
+burst = {{ .Burst }}
+{{- end }}
+
+# The gas price oracle suggests the priority fee of eth_gasPrice, eth_maxPriorityFeePerGas and
+# eth_fillTransaction from the lowest effective tips paid in the recent blocks.
+[json-rpc.gas-price-oracle]
+
+# Blocks is the number of recent blocks sampled.
+blocks = {{ .JSONRPC.GasPriceOracle.Blocks }}
+
+# Percentile is the percentile of the sampled tips suggested.
+percentile = {{ .JSONRPC.GasPriceOracle.Percentile }}
+
+# MaxPrice is the maximum tip suggested, in wei.
+max-price = {{ .JSONRPC.GasPriceOracle.MaxPrice }}
+
+# IgnorePrice is the tip, in wei, below which the transactions are not sampled.
+ignore-price = {{ .JSONRPC.GasPriceOracle.IgnorePrice }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
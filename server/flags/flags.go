@@ -41,13 +41,20 @@ const (
 	JSONRPCAPI                  = "json-rpc.api"
 	JSONRPCAddress              = "json-rpc.address"
 	JSONWsAddress               = "json-rpc.ws-address"
+	JSONRPCEnableGraphQL        = "json-rpc.enable-graphql"
+	JSONRPCGraphQLAddress       = "json-rpc.graphql-address"
+	JSONRPCIPCPath              = "json-rpc.ipc-path"
+	JSONRPCIPCFileMode          = "json-rpc.ipc-file-mode"
 	JSONRPCWSOrigins            = "json-rpc.ws-origins"
 	JSONRPCGasCap               = "json-rpc.gas-cap"
 	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
 	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
 	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
+	JSONRPCTxSyncDefaultTimeout = "json-rpc.tx-sync-default-timeout"
+	JSONRPCTxSyncMaxTimeout     = "json-rpc.tx-sync-max-timeout"
 	JSONRPCLogsCap              = "json-rpc.logs-cap"
 	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
+	JSONRPCResponseCacheSize    = "json-rpc.response-cache-size"
 	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
 	JSONRPCHTTPBodyLimit        = "json-rpc.http-body-limit"
@@ -57,6 +64,18 @@ const (
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCAllowAddPeer         = "json-rpc.allow-add-peer"
+	JSONRPCExternalSigner       = "json-rpc.external-signer"
+	JSONRPCJWTSecret            = "json-rpc.jwt-secret"
+	JSONRPCJWTNamespaces        = "json-rpc.jwt-namespaces"
+	JSONRPCPrivateTxPeers       = "json-rpc.private-tx-peers"
+	JSONRPCRateLimitEnable      = "json-rpc.rate-limit.enable"
+	JSONRPCRateLimitRPS         = "json-rpc.rate-limit.requests-per-second"
+	JSONRPCRateLimitBurst       = "json-rpc.rate-limit.burst"
+	JSONRPCGPOBlocks            = "json-rpc.gas-price-oracle.blocks"
+	JSONRPCGPOPercentile        = "json-rpc.gas-price-oracle.percentile"
+	JSONRPCGPOMaxPrice          = "json-rpc.gas-price-oracle.max-price"
+	JSONRPCGPOIgnorePrice       = "json-rpc.gas-price-oracle.ignore-price"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -84,6 +103,12 @@ const (
 	EVMMempoolCheckTxTimeout           = "evm.mempool.check-tx-timeout"
 	EVMMempoolInsertQueueSize          = "evm.mempool.insert-queue-size"
 	EVMMempoolEnableTxTracker          = "evm.mempool.enable-tx-tracker"
+	EVMMempoolJournal                  = "evm.mempool.journal"
+	EVMMempoolRejournal                = "evm.mempool.rejournal"
+	EVMMempoolJournalTxs               = "evm.mempool.journal-txs"
+	EVMMempoolCosmosPriceBump          = "evm.mempool.cosmos-price-bump"
+	EVMMempoolCosmosAccountSlots       = "evm.mempool.cosmos-account-slots"
+	EVMMempoolLanes                    = "evm.mempool.lanes"
 )
 
 // TLS flags
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/graphql"
+	serverconfig "github.com/cosmos/evm/server/config"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// startGraphQL starts the EIP-1767 GraphQL server, resolving the queries with
+// the JSON-RPC backend. The queries are served behind the middlewares of the
+// JSON-RPC server, a query being limited and recorded as a single call. It is
+// stopped with the context.
+func startGraphQL(
+	ctx context.Context,
+	srvCtx *server.Context,
+	g *errgroup.Group,
+	config *serverconfig.Config,
+	evmBackend *backend.Backend,
+	middlewares func(http.Handler) http.Handler,
+) error {
+	logger := srvCtx.Logger.With("module", "graphql")
+
+	handler, err := graphql.NewHandler(logger, evmBackend, config.JSONRPC.HTTPBodyLimit)
+	if err != nil {
+		return err
+	}
+
+	r := mux.NewRouter()
+	r.Handle("/graphql", middlewares(handler)).Methods("POST")
+
+	handlerWithCors := cors.Default()
+	if config.API.EnableUnsafeCORS {
+		handlerWithCors = cors.AllowAll()
+	}
+
+	httpSrv := &http.Server{
+		Addr:              config.JSONRPC.GraphQLAddress,
+		Handler:           handlerWithCors.Handler(r),
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(httpSrv.Addr, config)
+	if err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		srvCtx.Logger.Info("Starting GraphQL server", "address", config.JSONRPC.GraphQLAddress)
+		errCh := make(chan error)
+		go func() {
+			errCh <- httpSrv.Serve(ln)
+		}()
+
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping GraphQL server...", "address", config.JSONRPC.GraphQLAddress, "timeout", shutdownTimeout)
+			ctxShutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			if err := httpSrv.Shutdown(ctxShutdown); err != nil {
+				logger.Error("failed to shutdown GraphQL server", "error", err.Error())
+			}
+			return nil
+		case err := <-errCh:
+			if err == http.ErrServerClosed {
+				return nil
+			}
+
+			srvCtx.Logger.Error("failed to start GraphQL server", "error", err.Error())
+			return err
+		}
+	})
+
+	return nil
+}
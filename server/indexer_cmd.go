@@ -18,11 +18,12 @@ import (
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|logs]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- logs: re-index the blocks whose logs have not been indexed yet, to backfill the log indexes used by eth_getLogs.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
@@ -36,8 +37,8 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "logs" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|logs, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
@@ -110,6 +111,19 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "logs":
+				for i := max(blockStore.Base(), 1); i <= blockStore.Height(); i++ {
+					indexed, err := idxer.LogsIndexed(i)
+					if err != nil {
+						return err
+					}
+					if indexed {
+						continue
+					}
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
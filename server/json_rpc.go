@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -15,9 +16,14 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/auth"
 	"github.com/cosmos/evm/rpc/backend"
+	rpcmetrics "github.com/cosmos/evm/rpc/metrics"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	serverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
 	"github.com/cosmos/evm/server/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -48,8 +54,18 @@ func StartJSONRPC(
 		return nil, fmt.Errorf("client %T does not implement EventsClient", clientCtx.Client)
 	}
 
-	stream := stream.NewRPCStreams(evtClient, logger, clientCtx.TxConfig.TxDecoder())
-	app.RegisterPendingTxListener(stream.ListenPendingTx)
+	rpcStream := stream.NewRPCStreams(evtClient, logger, clientCtx.TxConfig.TxDecoder())
+	app.RegisterPendingTxListener(rpcStream.ListenPendingTx)
+	if txPool, ok := mempool.(stream.TxPool); ok {
+		rpcStream.ListenTxPool(txPool)
+	}
+
+	// the private txs are forwarded with a JWT signed with the secret of the
+	// node, so that the trusted peers sharing it can authenticate them
+	privateTxAuth, err := auth.NewHTTPAuth(config.JSONRPC.JWTSecret)
+	if err != nil {
+		return nil, err
+	}
 
 	evmBackend := backend.NewBackend(
 		srvCtx,
@@ -58,9 +74,10 @@ func StartJSONRPC(
 		mempool,
 		backend.WithUnprotectedTxs(config.JSONRPC.AllowUnprotectedTxs),
 		backend.WithLogger(srvCtx.Logger),
+		backend.WithPrivateTxAuth(privateTxAuth),
 	)
 
-	apis := rpc.BuildRPCs(config.JSONRPC.API, srvCtx, clientCtx, stream, evmBackend)
+	apis := rpc.BuildRPCs(config.JSONRPC.API, srvCtx, clientCtx, rpcStream, evmBackend)
 
 	rpcServer := ethrpc.NewServer()
 	rpcServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
@@ -77,8 +94,26 @@ func StartJSONRPC(
 		}
 	}
 
+	// marks the requests forwarded by the websocket server to the http server
+	internal := rpctypes.NewInternalMarker()
+	limiter := ratelimit.NewLimiter(config.JSONRPC.RateLimit, internal)
+	jwtAuth, err := auth.NewJWTAuth(config.JSONRPC.JWTSecret, config.JSONRPC.JWTNamespaces, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	// the per method metrics are exposed by the JSON-RPC metrics server
+	rpcMetrics := rpcmetrics.NewMetrics(srvCtx.Viper.GetBool(srvflags.JSONRPCEnableMetrics), apis, internal)
+
+	// the GraphQL server is served behind the same middlewares
+	middlewares := func(next http.Handler) http.Handler {
+		next = jwtAuth.Handler(next, config.JSONRPC.HTTPBodyLimit)
+		next = limiter.Handler(next, config.JSONRPC.HTTPBodyLimit)
+		return rpcMetrics.Handler(next, config.JSONRPC.HTTPBodyLimit)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", middlewares(rpcServer)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -133,7 +168,56 @@ func StartJSONRPC(
 
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, rpcStream, evmBackend, config, internal, limiter, jwtAuth, rpcMetrics)
 	wsSrv.Start()
+
+	if config.JSONRPC.IPCPath != "" {
+		if err := startIPC(ctx, srvCtx, clientCtx, g, config, rpcStream, evmBackend, rpcServer); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.JSONRPC.EnableGraphQL {
+		if err := startGraphQL(ctx, srvCtx, g, config, evmBackend, middlewares); err != nil {
+			return nil, fmt.Errorf("failed to start GraphQL server: %w", err)
+		}
+	}
 	return httpSrv, nil
 }
+
+// startIPC starts the IPC server on the unix domain socket, serving the calls
+// with the same RPC server as the HTTP one. It is stopped with the context.
+func startIPC(
+	ctx context.Context,
+	srvCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+	config *serverconfig.Config,
+	stream *stream.RPCStream,
+	evmBackend *backend.Backend,
+	rpcServer *ethrpc.Server,
+) error {
+	fileMode, err := serverconfig.ParseIPCFileMode(config.JSONRPC.IPCFileMode)
+	if err != nil {
+		return err
+	}
+
+	ipcPath := config.JSONRPC.IPCPath
+	if !filepath.IsAbs(ipcPath) {
+		ipcPath = filepath.Join(srvCtx.Config.RootDir, ipcPath)
+	}
+
+	srvCtx.Logger.Info("Starting JSON-RPC IPC server", "path", ipcPath)
+	ipcSrv := rpc.NewIPCServer(clientCtx, srvCtx.Logger.With("module", "geth"), stream, evmBackend, rpcServer, ipcPath, fileMode)
+	if err := ipcSrv.Start(); err != nil {
+		return fmt.Errorf("failed to start IPC server: %w", err)
+	}
+
+	g.Go(func() error {
+		<-ctx.Done()
+		srvCtx.Logger.Info("stopping JSON-RPC IPC server...", "path", ipcPath)
+		ipcSrv.Stop()
+		return nil
+	})
+	return nil
+}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"math"
 	"path/filepath"
+	"strconv"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/holiman/uint256"
 	"github.com/spf13/cast"
 
@@ -13,9 +16,11 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	serverconfig "github.com/cosmos/evm/server/config"
 	srvflags "github.com/cosmos/evm/server/flags"
 
 	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdkserver "github.com/cosmos/cosmos-sdk/server"
@@ -78,6 +83,12 @@ func ResolveMempoolConfig(anteHandler sdk.AnteHandler, appOpts servertypes.AppOp
 		PendingTxProposalTimeout: GetPendingTxProposalTimeout(appOpts, logger),
 		InsertQueueSize:          GetMempoolInsertQueueSize(appOpts, logger),
 		EnableTxTracker:          GetMempoolEnableTxTracker(appOpts),
+		JournalPath:              GetMempoolJournalPath(appOpts),
+		JournalInterval:          GetMempoolRejournal(appOpts),
+		JournalTxs:               GetMempoolJournalTxs(appOpts),
+		CosmosPriceBump:          GetMempoolCosmosPriceBump(appOpts),
+		CosmosAccountSlots:       GetMempoolCosmosAccountSlots(appOpts),
+		Lanes:                    GetMempoolLanes(appOpts, logger),
 	}
 }
 
@@ -235,6 +246,120 @@ func GetMempoolEnableTxTracker(appOpts servertypes.AppOptions) bool {
 	return cast.ToBool(appOpts.Get(srvflags.EVMMempoolEnableTxTracker))
 }
 
+// GetMempoolJournalPath returns the path of the mempool journal, resolved
+// against the data directory if relative. Empty disables the journal.
+func GetMempoolJournalPath(appOpts servertypes.AppOptions) string {
+	if appOpts == nil {
+		return ""
+	}
+
+	journal := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournal))
+	if journal == "" || filepath.IsAbs(journal) {
+		return journal
+	}
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	return filepath.Join(homeDir, "data", journal)
+}
+
+// GetMempoolRejournal reads the time interval to regenerate the mempool journal.
+func GetMempoolRejournal(appOpts servertypes.AppOptions) time.Duration {
+	if appOpts == nil {
+		return 0
+	}
+	return cast.ToDuration(appOpts.Get(srvflags.EVMMempoolRejournal))
+}
+
+// GetMempoolJournalTxs reads the transactions to journal, all by default.
+func GetMempoolJournalTxs(appOpts servertypes.AppOptions) string {
+	if appOpts == nil {
+		return evmmempool.JournalTxsAll
+	}
+	if txs := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournalTxs)); txs != "" {
+		return txs
+	}
+	return evmmempool.JournalTxsAll
+}
+
+// GetMempoolCosmosPriceBump reads the minimum fee bump percentage to replace a
+// pooled cosmos tx. Zero selects the mempool default.
+func GetMempoolCosmosPriceBump(appOpts servertypes.AppOptions) uint64 {
+	if appOpts == nil {
+		return 0
+	}
+	return cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosPriceBump))
+}
+
+// GetMempoolCosmosAccountSlots reads the maximum number of cosmos txs pooled
+// per account. Zero selects the mempool default.
+func GetMempoolCosmosAccountSlots(appOpts servertypes.AppOptions) uint64 {
+	if appOpts == nil {
+		return 0
+	}
+	return cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosAccountSlots))
+}
+
+// GetMempoolLanes reads the block building lanes of the mempool from the
+// [[evm.mempool.lanes]] tables of app.toml. It returns no lanes if they are
+// invalid.
+func GetMempoolLanes(appOpts servertypes.AppOptions, logger log.Logger) []evmmempool.Lane {
+	if appOpts == nil {
+		return nil
+	}
+	raw := appOpts.Get(srvflags.EVMMempoolLanes)
+	if raw == nil {
+		return nil
+	}
+
+	var configs []serverconfig.LaneConfig
+	if err := mapstructure.WeakDecode(raw, &configs); err != nil {
+		logger.Error("failed to decode mempool lanes, using no lanes", "error", err)
+		return nil
+	}
+	lanes := make([]evmmempool.Lane, len(configs))
+	for i, cfg := range configs {
+		if err := cfg.Validate(); err != nil {
+			logger.Error("invalid mempool lane, using no lanes", "error", err)
+			return nil
+		}
+		lanes[i] = mempoolLane(cfg)
+	}
+	return lanes
+}
+
+// mempoolLane converts the valid lane configuration to a mempool lane.
+func mempoolLane(cfg serverconfig.LaneConfig) evmmempool.Lane {
+	var matchers []evmmempool.TxMatcher
+	if len(cfg.MsgTypes) > 0 {
+		matchers = append(matchers, evmmempool.MatchMsgTypes(cfg.MsgTypes...))
+	}
+	if len(cfg.Contracts) > 0 {
+		contracts := make([]common.Address, len(cfg.Contracts))
+		for i, contract := range cfg.Contracts {
+			contracts[i] = common.HexToAddress(contract)
+		}
+		matchers = append(matchers, evmmempool.MatchContracts(contracts...))
+	}
+	if len(cfg.Senders) > 0 {
+		senders := make([]common.Address, len(cfg.Senders))
+		for i, sender := range cfg.Senders {
+			senders[i] = common.HexToAddress(sender)
+		}
+		matchers = append(matchers, evmmempool.MatchSenders(senders...))
+	}
+
+	lane := evmmempool.Lane{
+		Name:    cfg.Name,
+		Matcher: evmmempool.MatchAny(matchers...),
+	}
+	if cfg.Ordering == "arrival" {
+		lane.Ordering = evmmempool.OrderByArrival
+	}
+	if cfg.MaxBlockGasShare > 0 {
+		lane.MaxBlockGasShare = sdkmath.LegacyMustNewDecFromStr(strconv.FormatFloat(cfg.MaxBlockGasShare, 'f', -1, 64))
+	}
+	return lane
+}
+
 func GetMempoolCheckTxTimeout(appOpts servertypes.AppOptions, logger log.Logger) time.Duration {
 	if appOpts == nil {
 		logger.Error("app options is nil, using check tx timeout of 5 seconds")
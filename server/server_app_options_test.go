@@ -1,17 +1,26 @@
 package server
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math"
 	"os"
 	"path/filepath"
 	"testing"
+	"text/template"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 
+	evmmempool "github.com/cosmos/evm/mempool"
+	serverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+
 	"cosmossdk.io/log/v2"
 	sdkmath "cosmossdk.io/math"
 
@@ -418,3 +427,54 @@ func TestValidateReapBounds(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMempoolLanes(t *testing.T) {
+	cfg := serverconfig.DefaultConfig()
+	cfg.EVM.Mempool.Lanes = []serverconfig.LaneConfig{
+		{
+			Name:             "system",
+			Contracts:        []string{"0x0000000000000000000000000000000000000900"},
+			Ordering:         "arrival",
+			MaxBlockGasShare: 0.1,
+		},
+		{
+			Name:     "oracle",
+			MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			Senders:  []string{"0x0000000000000000000000000000000000000901"},
+		},
+	}
+
+	// the lanes are read from the tables written to app.toml
+	tmpl, err := template.New("app").Parse(serverconfig.DefaultEVMConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, cfg))
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+
+	lanes := GetMempoolLanes(v, log.NewNopLogger())
+	require.Len(t, lanes, 2)
+	require.Equal(t, "system", lanes[0].Name)
+	require.Equal(t, evmmempool.OrderByArrival, lanes[0].Ordering)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(1, 1), lanes[0].MaxBlockGasShare)
+	system := common.HexToAddress("0x0000000000000000000000000000000000000900")
+	require.True(t, lanes[0].Matcher.MatchEVMTx(ethtypes.NewTx(&ethtypes.LegacyTx{To: &system}), common.Address{}))
+	require.Equal(t, "oracle", lanes[1].Name)
+	require.Equal(t, evmmempool.OrderByFee, lanes[1].Ordering)
+	require.True(t, lanes[1].MaxBlockGasShare.IsNil())
+	require.True(t, lanes[1].Matcher.MatchEVMTx(ethtypes.NewTx(&ethtypes.LegacyTx{}), common.HexToAddress("0x0000000000000000000000000000000000000901")))
+
+	// no lanes are configured by default
+	buf.Reset()
+	require.NoError(t, tmpl.Execute(&buf, serverconfig.DefaultConfig()))
+	v = viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	require.Empty(t, GetMempoolLanes(v, log.NewNopLogger()))
+
+	// the invalid lanes are ignored
+	opts := newMockAppOptions()
+	opts.Set(srvflags.EVMMempoolLanes, []interface{}{map[string]interface{}{"name": "default", "senders": []string{"0x0000000000000000000000000000000000000901"}}})
+	require.Empty(t, GetMempoolLanes(opts, log.NewNopLogger()))
+}
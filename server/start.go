@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"runtime/pprof"
 
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/spf13/cobra"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -204,11 +205,17 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, cosmosevmserverconfig.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, cosmosevmserverconfig.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, cosmosevmserverconfig.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableGraphQL, false, "Define if the EIP-1767 GraphQL server should be enabled with the JSON-RPC server")
+	cmd.Flags().String(srvflags.JSONRPCGraphQLAddress, cosmosevmserverconfig.DefaultGraphQLAddress, "the GraphQL server address to listen on")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "the IPC unix domain socket path, relative to the node home if not absolute (disabled if empty)")
+	cmd.Flags().String(srvflags.JSONRPCIPCFileMode, cosmosevmserverconfig.DefaultIPCFileMode, "the octal permissions of the IPC socket file")
 	cmd.Flags().StringSlice(srvflags.JSONRPCWSOrigins, cosmosevmserverconfig.GetDefaultWSOrigins(), "Defines a list of WebSocket origins that should be allowed to connect")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aatom (0=infinite)")                         //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, cosmosevmserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCTxSyncDefaultTimeout, cosmosevmserverconfig.DefaultTxSyncTimeout, "Sets the default time eth_sendRawTransactionSync waits for the receipt")
+	cmd.Flags().Duration(srvflags.JSONRPCTxSyncMaxTimeout, cosmosevmserverconfig.DefaultTxSyncMaxTimeout, "Sets the max time eth_sendRawTransactionSync waits for the receipt")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Int(srvflags.JSONRPCHTTPBodyLimit, cosmosevmserverconfig.DefaultHTTPBodyLimit, "Sets max request body size in bytes for json-rpc http server")
@@ -217,10 +224,23 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, cosmosevmserverconfig.DefaultBatchResponseMaxSize, "Maximum size of server response")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int(srvflags.JSONRPCResponseCacheSize, cosmosevmserverconfig.DefaultResponseCacheSize, "Sets the number of blocks, receipts and transactions of each kind cached to serve the historical queries (0 disables the cache)")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Bool(srvflags.JSONRPCAllowAddPeer, false, "Allows adding persistent peers with admin_addPeer")
+	cmd.Flags().String(srvflags.JSONRPCExternalSigner, "", "the HTTP URL or IPC socket path of a Clef compatible external signer used instead of the keyring")
+	cmd.Flags().String(srvflags.JSONRPCJWTSecret, "", "Sets the path of the hex encoded secret authenticating the calls to the jwt-namespaces")
+	cmd.Flags().StringSlice(srvflags.JSONRPCJWTNamespaces, cosmosevmserverconfig.GetDefaultJWTNamespaces(), "Defines the namespaces whose calls require JWT authentication")
+	cmd.Flags().StringSlice(srvflags.JSONRPCPrivateTxPeers, nil, "Defines the JSON-RPC HTTP URLs of the trusted validators the private transactions are forwarded to")
+	cmd.Flags().Bool(srvflags.JSONRPCRateLimitEnable, false, "Enables the per client rate limiting of the json-rpc calls")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitRPS, cosmosevmserverconfig.DefaultRateLimitConfig().RequestsPerSecond, "Sets the rate of json-rpc calls allowed per client for the methods without a specific limit")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, cosmosevmserverconfig.DefaultRateLimitConfig().Burst, "Sets the number of json-rpc calls a client can make at once for the methods without a specific limit")
+	cmd.Flags().Int(srvflags.JSONRPCGPOBlocks, cosmosevmserverconfig.DefaultGasPriceOracleConfig().Blocks, "Sets the number of recent blocks sampled by the gas price oracle")
+	cmd.Flags().Int(srvflags.JSONRPCGPOPercentile, cosmosevmserverconfig.DefaultGasPriceOracleConfig().Percentile, "Sets the percentile of the sampled tips suggested by the gas price oracle")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOMaxPrice, cosmosevmserverconfig.DefaultGasPriceOracleConfig().MaxPrice, "Sets the maximum tip in wei suggested by the gas price oracle")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOIgnorePrice, cosmosevmserverconfig.DefaultGasPriceOracleConfig().IgnorePrice, "Sets the tip in wei below which the transactions are not sampled by the gas price oracle")
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -243,6 +263,11 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Duration(srvflags.EVMMempoolCheckTxTimeout, mpDefaults.CheckTxTimeout, "timeout for async CheckTx handler")
 	cmd.Flags().Int(srvflags.EVMMempoolInsertQueueSize, mpDefaults.InsertQueueSize, "the maximum number of transactions that can be in the insert queue at once")
 	cmd.Flags().Bool(srvflags.EVMMempoolEnableTxTracker, mpDefaults.EnableTxTracker, "enable per-tx lifecycle telemetry from the mempool (queued/pending/included latencies)")
+	cmd.Flags().String(srvflags.EVMMempoolJournal, mpDefaults.Journal, "the journal of the pooled transactions to survive node restarts, relative to the data directory (empty disables the journal)")
+	cmd.Flags().Duration(srvflags.EVMMempoolRejournal, mpDefaults.Rejournal, "the time interval to regenerate the mempool journal")
+	cmd.Flags().String(srvflags.EVMMempoolJournalTxs, mpDefaults.JournalTxs, "the transactions to journal: all, evm or cosmos")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosPriceBump, mpDefaults.CosmosPriceBump, "the minimum fee bump percentage to replace an already existing cosmos transaction (signer sequence)")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosAccountSlots, mpDefaults.CosmosAccountSlots, "the maximum number of cosmos transactions pooled per account")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -467,6 +492,16 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		if m, ok := evmApp.GetMempool().(EventBusser); ok && m != nil {
 			m.SetEventBus(bftNode.EventBus())
 		}
+
+		// replay the mempool journal once the chain state is loaded
+		type JournalLoader interface {
+			LoadJournal() error
+		}
+		if m, ok := evmApp.GetMempool().(JournalLoader); ok && m != nil {
+			if err := m.LoadJournal(); err != nil {
+				logger.Error("failed to load mempool journal", "error", err.Error())
+			}
+		}
 		defer func() {
 			if bftNode.IsRunning() {
 				_ = bftNode.Stop()
@@ -498,6 +533,8 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	// Enable metrics if JSONRPC is enabled and --metrics is passed
 	// Flag not added in config to avoid user enabling in config without passing in CLI
 	if config.JSONRPC.Enable && svrCtx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		// the latency histograms of the JSON-RPC methods are only sampled once enabled
+		gethmetrics.Enable()
 		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
 	}
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -18,3 +19,31 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMAddressTxIndexer defines the interface of an eth tx indexer which also
+// indexes the txs by address, as required by the ots namespace.
+type EVMAddressTxIndexer interface {
+	EVMTxIndexer
+
+	// GetByAddress returns the hashes of the txs sent from, sent to or creating
+	// the address, in the blocks after (or before when reverse is set) the given
+	// block, and whether more txs remain.
+	GetByAddress(address common.Address, blockNumber int64, reverse bool, limit int) ([]common.Hash, bool, error)
+	// GetBySenderAndNonce returns nil if tx not found.
+	GetBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error)
+	// GetContractCreation returns nil if tx not found.
+	GetContractCreation(contract common.Address) (*common.Hash, error)
+}
+
+// EVMLogIndexer defines the interface of an eth tx indexer which also indexes
+// the logs by address and topics, to serve eth_getLogs without scanning blocks.
+type EVMLogIndexer interface {
+	EVMTxIndexer
+
+	// GetLogsByHeight returns false if the logs of the block are not indexed.
+	GetLogsByHeight(height int64) ([][]*ethtypes.Log, bool, error)
+	// GetLogBlocks returns the heights of the blocks in the [from, to] range
+	// with logs matching the criteria, or false if the range is not fully indexed
+	// or is too large to be looked up.
+	GetLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
+}
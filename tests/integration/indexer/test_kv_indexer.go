@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -18,10 +19,13 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/log/v2"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
@@ -171,6 +175,11 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				last, err := idxer.LastIndexedBlock()
 				require.NoError(t, err)
 				require.Equal(t, int64(-1), last)
+
+				hashes, more, err := idxer.GetByAddress(from, 0, true, 10)
+				require.NoError(t, err)
+				require.Empty(t, hashes)
+				require.False(t, more)
 			} else {
 				first, err := idxer.FirstIndexedBlock()
 				require.NoError(t, err)
@@ -186,7 +195,251 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				for _, address := range []common.Address{from, to} {
+					hashes, more, err := idxer.GetByAddress(address, 0, true, 10)
+					require.NoError(t, err)
+					require.Equal(t, []common.Hash{txHash}, hashes)
+					require.False(t, more)
+
+					hashes, _, err = idxer.GetByAddress(address, 0, false, 10)
+					require.NoError(t, err)
+					require.Equal(t, []common.Hash{txHash}, hashes)
+
+					hashes, _, err = idxer.GetByAddress(address, tc.block.Height, false, 10)
+					require.NoError(t, err)
+					require.Empty(t, hashes)
+				}
+
+				hash, err := idxer.GetBySenderAndNonce(from, 0)
+				require.NoError(t, err)
+				require.Equal(t, &txHash, hash)
+
+				hash, err = idxer.GetBySenderAndNonce(from, 1)
+				require.NoError(t, err)
+				require.Nil(t, hash)
 			}
 		})
 	}
 }
+
+func TestKVIndexerAddressIndexes(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+	to := common.BigToAddress(big.NewInt(1))
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+	// index a contract creation in block 1, then a transfer in each of the blocks 2 and 3
+	hashes := make([]common.Hash, 3)
+	for i := range hashes {
+		ethTxParams := types.EvmTxArgs{
+			Nonce:    uint64(i), //nolint:gosec // G115
+			Amount:   big.NewInt(1000),
+			GasLimit: 100000,
+		}
+		if i > 0 {
+			ethTxParams.To = &to
+		} else {
+			ethTxParams.Input = []byte{0x00}
+		}
+		tx := types.NewTx(&ethTxParams)
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		hashes[i] = tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: int64(i + 1)}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: hashes[i].Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "53000"},
+					}},
+				},
+			},
+		}))
+	}
+
+	testCases := []struct {
+		name      string
+		address   common.Address
+		block     int64
+		reverse   bool
+		limit     int
+		expHashes []common.Hash
+		expMore   bool
+	}{
+		{"newest page", from, 0, true, 2, []common.Hash{hashes[2], hashes[1]}, true},
+		{"before block", from, 2, true, 2, []common.Hash{hashes[0]}, false},
+		{"oldest page", from, 0, false, 2, []common.Hash{hashes[0], hashes[1]}, true},
+		{"after block", from, 1, false, 1, []common.Hash{hashes[1]}, true},
+		{"recipient", to, 0, true, 10, []common.Hash{hashes[2], hashes[1]}, false},
+		{"unknown address", common.BigToAddress(big.NewInt(2)), 0, true, 10, []common.Hash{}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, more, err := idxer.GetByAddress(tc.address, tc.block, tc.reverse, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHashes, res)
+			require.Equal(t, tc.expMore, more)
+		})
+	}
+
+	contract := crypto.CreateAddress(from, 0)
+	res, _, err := idxer.GetByAddress(contract, 0, true, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hashes[0]}, res)
+
+	creation, err := idxer.GetContractCreation(contract)
+	require.NoError(t, err)
+	require.Equal(t, &hashes[0], creation)
+
+	creation, err = idxer.GetContractCreation(to)
+	require.NoError(t, err)
+	require.Nil(t, creation)
+
+	for i, hash := range hashes {
+		res, err := idxer.GetBySenderAndNonce(from, uint64(i)) //nolint:gosec // G115
+		require.NoError(t, err)
+		require.Equal(t, &hash, res)
+	}
+}
+
+func TestKVIndexerLogIndexes(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+	var (
+		contract1 = common.BigToAddress(big.NewInt(1))
+		contract2 = common.BigToAddress(big.NewInt(2))
+		topicA    = common.BigToHash(big.NewInt(10))
+		topicB    = common.BigToHash(big.NewInt(11))
+	)
+	// the logs emitted by the tx of each of the blocks 1 to 3
+	blockLogs := [][]*types.Log{
+		{{Address: contract1.Hex(), Topics: []string{topicA.Hex()}, Data: []byte{0x01}}},
+		{},
+		{
+			{Address: contract1.Hex(), Topics: []string{topicB.Hex(), topicA.Hex()}},
+			{Address: contract2.Hex(), Topics: []string{topicA.Hex()}, Index: 1},
+		},
+	}
+	hashes := make([]common.Hash, len(blockLogs))
+	for i, logs := range blockLogs {
+		height := int64(i + 1)
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    uint64(i), //nolint:gosec // G115
+			To:       &contract1,
+			GasLimit: 100000,
+		})
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		hashes[i] = tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		for _, l := range logs {
+			l.TxHash = hashes[i].Hex()
+			l.BlockNumber = uint64(height) //nolint:gosec // G115
+		}
+		anyRsp, err := codectypes.NewAnyWithValue(&types.MsgEthereumTxResponse{Hash: hashes[i].Hex(), Logs: logs})
+		require.NoError(t, err)
+		data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{anyRsp}})
+		require.NoError(t, err)
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Data: data,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: hashes[i].Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "50000"},
+					}},
+				},
+			},
+		}))
+	}
+
+	for i, logs := range blockLogs {
+		height := int64(i + 1)
+		indexed, err := idxer.LogsIndexed(height)
+		require.NoError(t, err)
+		require.True(t, indexed)
+
+		res, indexed, err := idxer.GetLogsByHeight(height)
+		require.NoError(t, err)
+		require.True(t, indexed)
+		if len(logs) == 0 {
+			require.Empty(t, res)
+			continue
+		}
+		require.Len(t, res, 1)
+		require.Len(t, res[0], len(logs))
+		for j, l := range logs {
+			require.Equal(t, l.ToEthereum(), res[0][j])
+		}
+	}
+
+	_, indexed, err := idxer.GetLogsByHeight(4)
+	require.NoError(t, err)
+	require.False(t, indexed)
+
+	testCases := []struct {
+		name       string
+		from, to   int64
+		addresses  []common.Address
+		topics     [][]common.Hash
+		expHeights []int64
+		expIndexed bool
+	}{
+		{"all blocks with logs", 1, 3, nil, nil, []int64{1, 3}, true},
+		{"address", 1, 3, []common.Address{contract2}, nil, []int64{3}, true},
+		{"any of the addresses", 1, 3, []common.Address{contract1, contract2}, nil, []int64{1, 3}, true},
+		{"first topic", 1, 3, nil, [][]common.Hash{{topicA}}, []int64{1, 3}, true},
+		{"second topic", 1, 3, nil, [][]common.Hash{{}, {topicA}}, []int64{3}, true},
+		{"address and topic", 1, 3, []common.Address{contract2}, [][]common.Hash{{topicB}}, []int64{3}, true},
+		{"no match", 1, 2, []common.Address{contract2}, nil, nil, true},
+		{"range partially indexed", 2, 4, nil, nil, nil, false},
+		{"range over the lookup cap", 1, indexer.MaxLogBlockRange + 1, nil, nil, nil, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			heights, indexed, err := idxer.GetLogBlocks(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expIndexed, indexed)
+			require.Equal(t, tc.expHeights, heights)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cometbft/cometbft/libs/bytes"
@@ -297,6 +299,75 @@ func (s *TestSuite) TestGetStorageAt() {
 	}
 }
 
+func (s *TestSuite) TestStorageRangeAt() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr := utiltx.GenerateAddress()
+	keys := []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))}
+
+	s.Run("fail - BlockHash and BlockNumber are both nil", func() {
+		s.SetupTest()
+		_, err := s.backend.StorageRangeAt(s.Ctx(), addr, nil, 1, rpctypes.BlockNumberOrHash{})
+		s.Require().Error(err)
+	})
+
+	s.Run("pass", func() {
+		s.SetupTest()
+		QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterStorageRange(QueryClient, addr, hexutil.Bytes{0x0}, 1,
+			[]evmtypes.State{evmtypes.NewState(keys[0], common.BigToHash(big.NewInt(10)))}, keys[1].Bytes())
+
+		res, err := s.backend.StorageRangeAt(s.Ctx(), addr, hexutil.Bytes{0x0}, 1, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+		s.Require().NoError(err)
+		s.Require().Equal(rpctypes.StorageRangeResult{
+			Storage: rpctypes.StorageMap{
+				crypto.Keccak256Hash(keys[0].Bytes()): {Key: &keys[0], Value: common.BigToHash(big.NewInt(10))},
+			},
+			NextKey: &keys[1],
+		}, res)
+	})
+}
+
+func (s *TestSuite) TestGetAccounts() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr1, addr2 := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+
+	s.Run("fail - BlockHash and BlockNumber are both nil", func() {
+		s.SetupTest()
+		_, err := s.backend.GetAccounts(s.Ctx(), []common.Address{addr1}, rpctypes.BlockNumberOrHash{})
+		s.Require().Error(err)
+	})
+
+	s.Run("fail - too many addresses", func() {
+		s.SetupTest()
+		_, err := s.backend.GetAccounts(s.Ctx(), make([]common.Address, 257), rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+		s.Require().ErrorContains(err, "too many addresses")
+	})
+
+	s.Run("pass", func() {
+		s.SetupTest()
+		QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterAccounts(QueryClient, []string{addr1.Hex(), addr2.Hex()}, []evmtypes.EthAccount{
+			{Address: addr1.Hex(), Balance: "10", CodeHash: codeHash.Hex(), Nonce: 2, StorageRoot: ethtypes.EmptyRootHash.Hex()},
+			{Address: addr2.Hex(), Balance: "0", CodeHash: codeHash.Hex(), StorageRoot: ethtypes.EmptyRootHash.Hex()},
+		})
+
+		res, err := s.backend.GetAccounts(s.Ctx(), []common.Address{addr1, addr2}, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+		s.Require().NoError(err)
+		s.Require().Equal([]*rpctypes.AccountInfo{
+			{CodeHash: codeHash, StorageRoot: ethtypes.EmptyRootHash, Balance: (*hexutil.Big)(big.NewInt(10)), Nonce: 2},
+			{CodeHash: codeHash, StorageRoot: ethtypes.EmptyRootHash, Balance: (*hexutil.Big)(big.NewInt(0)), Nonce: 0},
+		}, res)
+
+		RegisterAccounts(QueryClient, []string{addr1.Hex()}, []evmtypes.EthAccount{
+			{Address: addr1.Hex(), Balance: "10", CodeHash: codeHash.Hex(), Nonce: 2, StorageRoot: ethtypes.EmptyRootHash.Hex()},
+		})
+		acct, err := s.backend.GetAccount(s.Ctx(), addr1, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+		s.Require().NoError(err)
+		s.Require().Equal(res[0], acct)
+	})
+}
+
 func (s *TestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cometbft/cometbft/abci/types"
@@ -241,6 +242,84 @@ func (s *TestSuite) TestGetBlockByNumber() {
 	}
 }
 
+func (s *TestSuite) TestPendingBlock() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	pendingTx := msgEthereumTx.AsTransaction()
+	addr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name       string
+		pendingTxs ethtypes.Transactions
+		withTxs    bool
+	}{
+		{"pass - mempool without pending block", nil, false},
+		{"pass - empty pending block", nil, true},
+		{"pass - pending block with tx", ethtypes.Transactions{pendingTx}, true},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			if !tc.withTxs {
+				block, err := s.backend.PendingBlock(s.Ctx())
+				s.Require().NoError(err)
+				s.Require().Nil(block)
+				return
+			}
+			s.backend.Mempool = pendingMempool{Mempool: s.Mempool(), txs: tc.pendingTxs}
+
+			var header metadata.MD
+			height := int64(1)
+			client := s.backend.ClientCtx.Client.(*mocks.Client)
+			QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterParams(QueryClient, &header, height)
+			resBlock := RegisterBlock(client, height, nil)
+			RegisterBlockResults(client, height)
+			RegisterConsensusParams(client, height)
+			RegisterBaseFee(QueryClient, math.NewInt(1))
+			RegisterValidatorAccount(QueryClient, sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+
+			block, err := s.backend.PendingBlock(s.Ctx())
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(height+1), block.NumberU64())
+			s.Require().Equal(common.BytesToHash(resBlock.BlockID.Hash), block.ParentHash())
+			s.Require().Equal(ethtypes.EmptyRootHash, block.Root())
+			s.Require().Len(block.Transactions(), len(tc.pendingTxs))
+			var gasUsed uint64
+			for i, tx := range tc.pendingTxs {
+				s.Require().Equal(tx.Hash(), block.Transactions()[i].Hash())
+				gasUsed += tx.Gas()
+			}
+			s.Require().Equal(gasUsed, block.GasUsed())
+
+			// the pending block is served for the pending block number
+			res, err := s.backend.GetBlockByNumber(s.Ctx(), ethrpc.EthPendingBlockNumber, false)
+			s.Require().NoError(err)
+			s.Require().Equal((*hexutil.Big)(big.NewInt(height+1)), res["number"])
+			s.Require().Nil(res["hash"])
+			s.Require().Len(res["transactions"], len(tc.pendingTxs))
+
+			// the txs of the pending block are applied before the queries of
+			// the pending state
+			RegisterHeader(client, &height, nil)
+			QueryClient.EXPECT().Balance(mock.Anything, mock.MatchedBy(func(req *evmtypes.QueryBalanceRequest) bool {
+				if req.Address != addr.String() || len(req.PendingTxs) != len(tc.pendingTxs) {
+					return false
+				}
+				for i, tx := range tc.pendingTxs {
+					if req.PendingTxs[i].Hash() != tx.Hash() {
+						return false
+					}
+				}
+				return true
+			})).Return(&evmtypes.QueryBalanceResponse{Balance: "1"}, nil)
+			pending := ethrpc.EthPendingBlockNumber
+			balance, err := s.backend.GetBalance(s.Ctx(), addr, ethrpc.BlockNumberOrHash{BlockNumber: &pending})
+			s.Require().NoError(err)
+			s.Require().Equal(big.NewInt(1), balance.ToInt())
+		})
+	}
+}
+
 func (s *TestSuite) TestGetBlockByHash() {
 	var (
 		blockRes *cmtrpctypes.ResultBlockResults
@@ -28,6 +28,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func MatchByProto(exp proto.Message) any {
@@ -303,6 +304,21 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageRange(queryClient *mocks.EVMQueryClient, addr common.Address, keyStart []byte, limit uint64, storage []evmtypes.State, nextKey []byte) {
+	queryClient.EXPECT().StorageRange(mock.Anything, &evmtypes.QueryStorageRangeRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Key: keyStart, Limit: limit},
+	}).Return(&evmtypes.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil)
+}
+
+func RegisterAccounts(queryClient *mocks.EVMQueryClient, addresses []string, accounts []evmtypes.EthAccount) {
+	queryClient.EXPECT().Accounts(mock.Anything, &evmtypes.QueryAccountsRequest{Addresses: addresses}).
+		Return(&evmtypes.QueryAccountsResponse{Accounts: accounts}, nil)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.EXPECT().Account(mock.Anything, &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
@@ -71,3 +71,13 @@ type mockMempoolSubscription struct{}
 
 func (ms *mockMempoolSubscription) Err() <-chan error { return make(chan error) }
 func (ms *mockMempoolSubscription) Unsubscribe()      {}
+
+// pendingMempool is a mempool returning the txs of the pending block.
+type pendingMempool struct {
+	*mocks.Mempool
+	txs gethtypes.Transactions
+}
+
+func (m pendingMempool) PendingEVMTxs(context.Context, uint64) gethtypes.Transactions {
+	return m.txs
+}
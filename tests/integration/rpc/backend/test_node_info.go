@@ -12,6 +12,7 @@ import (
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/keystore"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
@@ -362,3 +363,88 @@ func (s *TestSuite) TestImportRawKey() {
 		})
 	}
 }
+
+func (s *TestSuite) TestImportExportKeystore() {
+	priv, _ := ethsecp256k1.GenerateKey()
+	pubAddr := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	keyJSON, err := keystore.EncryptKey(priv, "password", keystore.KDFPBKDF2)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		keyJSON       string
+		password      string
+		expImportPass bool
+		expExportPass bool
+	}{
+		{
+			"fail - wrong password",
+			func() {},
+			string(keyJSON),
+			"wrong",
+			false,
+			false,
+		},
+		{
+			"fail - export forbidden without insecure unlock",
+			func() { s.backend.Cfg.JSONRPC.AllowInsecureUnlock = false },
+			string(keyJSON),
+			"password",
+			true,
+			false,
+		},
+		{
+			"fail - export forbidden without JWT secret",
+			func() { s.backend.Cfg.JSONRPC.JWTSecret = "" },
+			string(keyJSON),
+			"password",
+			true,
+			false,
+		},
+		{
+			"fail - export forbidden if the personal namespace is not protected",
+			func() { s.backend.Cfg.JSONRPC.JWTNamespaces = []string{"debug"} },
+			string(keyJSON),
+			"password",
+			true,
+			false,
+		},
+		{
+			"pass - import and export the key",
+			func() {},
+			string(keyJSON),
+			"password",
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			s.backend.Cfg.JSONRPC.JWTSecret = "jwt.hex"
+			s.backend.Cfg.JSONRPC.JWTNamespaces = []string{"personal"}
+			tc.malleate()
+
+			addr, err := s.backend.ImportKeystore(tc.keyJSON, tc.password)
+			if !tc.expImportPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(pubAddr, addr)
+
+			exported, err := s.backend.ExportKeystore(addr, "new password")
+			if !tc.expExportPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			decrypted, err := keystore.DecryptKey(exported, "new password")
+			s.Require().NoError(err)
+			s.Require().Equal(priv.Key, decrypted.Key)
+		})
+	}
+}
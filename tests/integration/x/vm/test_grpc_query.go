@@ -11,13 +11,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -33,6 +37,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
@@ -321,6 +326,121 @@ func (s *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageRange() {
+	newIndex := s.Keyring.AddKey()
+	addr := s.Keyring.GetAddr(newIndex)
+
+	var keys []common.Hash
+	for i := 1; i <= 3; i++ {
+		key := common.BigToHash(big.NewInt(int64(i)))
+		s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), addr, key, []byte{byte(i)})
+		keys = append(keys, key)
+	}
+
+	ctx := s.Network.GetContext()
+	_, err := s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{Address: invalidAddress})
+	s.Require().Error(err)
+
+	// the slots are paginated in the order of their keys
+	res, err := s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.State{
+		types.NewState(keys[0], common.BigToHash(big.NewInt(1))),
+		types.NewState(keys[1], common.BigToHash(big.NewInt(2))),
+	}, res.Storage)
+	s.Require().Equal(keys[2].Bytes(), res.Pagination.NextKey)
+
+	res, err = s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.State{types.NewState(keys[2], common.BigToHash(big.NewInt(3)))}, res.Storage)
+	s.Require().Empty(res.Pagination.NextKey)
+}
+
+func (s *KeeperTestSuite) TestQueryAccounts() {
+	ctx := s.Network.GetContext()
+
+	res, err := s.Network.GetEvmClient().Accounts(ctx, &types.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Limit: 1000, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Pagination.NextKey)
+	s.Require().Equal(uint64(len(res.Accounts)), res.Pagination.Total)
+	s.Require().Greater(len(res.Accounts), 2)
+
+	// the accounts are ordered by address and include the funded keys
+	addresses := make(map[string]bool, len(res.Accounts))
+	for i, acct := range res.Accounts {
+		if i > 0 {
+			s.Require().Negative(strings.Compare(strings.ToLower(res.Accounts[i-1].Address), strings.ToLower(acct.Address)))
+		}
+		addresses[acct.Address] = true
+	}
+	for _, addr := range s.Keyring.GetAllAccAddrs() {
+		s.Require().True(addresses[common.BytesToAddress(addr).Hex()])
+	}
+
+	// the next page starts at the next key
+	page, err := s.Network.GetEvmClient().Accounts(ctx, &types.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(res.Accounts[:1], page.Accounts)
+	s.Require().Equal(common.HexToAddress(res.Accounts[1].Address).Bytes(), page.Pagination.NextKey)
+
+	page, err = s.Network.GetEvmClient().Accounts(ctx, &types.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(res.Accounts[1:2], page.Accounts)
+
+	_, err = s.Network.GetEvmClient().Accounts(ctx, &types.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Offset: 1},
+	})
+	s.Require().Error(err)
+
+	// the accounts of the given addresses are returned in order
+	funded := s.Keyring.GetAddr(0)
+	unknown := tx.GenerateAddress()
+	res, err = s.Network.GetEvmClient().Accounts(ctx, &types.QueryAccountsRequest{
+		Addresses: []string{unknown.Hex(), funded.Hex()},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, 2)
+	s.Require().Equal(types.EthAccount{
+		Address:     unknown.Hex(),
+		Balance:     "0",
+		CodeHash:    common.BytesToHash(types.EmptyCodeHash).Hex(),
+		StorageRoot: ethtypes.EmptyRootHash.Hex(),
+	}, res.Accounts[0])
+	s.Require().Equal(funded.Hex(), res.Accounts[1].Address)
+	s.Require().NotEqual("0", res.Accounts[1].Balance)
+
+	_, err = s.Network.GetEvmClient().Accounts(ctx, &types.QueryAccountsRequest{
+		Addresses: []string{funded.Hex(), invalidAddress},
+	})
+	s.Require().Error(err)
+
+	// the number of addresses is capped
+	tooMany := make([]string, types.MaxAccountsQueryAddresses+1)
+	for i := range tooMany {
+		tooMany[i] = funded.Hex()
+	}
+	_, err = s.Network.GetEvmClient().Accounts(ctx, &types.QueryAccountsRequest{Addresses: tooMany})
+	s.Require().Error(err)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	res, err = s.Network.GetEvmClient().Accounts(ctx, &types.QueryAccountsRequest{
+		Addresses: tooMany[:types.MaxAccountsQueryAddresses],
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, types.MaxAccountsQueryAddresses)
+}
+
 func (s *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -2211,6 +2331,128 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	intermediate := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	recipient := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	oneEther := (*hexutil.Big)(big.NewInt(1e18))
+	halfEther := (*hexutil.Big)(big.NewInt(5e17))
+	highNonce := hexutil.Uint64(1000)
+	baseHeight := s.Network.GetContext().BlockHeight()
+
+	testCases := []struct {
+		name         string
+		opts         rpctypes.SimOpts
+		gasCap       uint64
+		expErrCode   int64
+		expBlocks    int
+		checkResults func(blocks []*rpctypes.SimBlockResult)
+	}{
+		{
+			name:       "empty input",
+			opts:       rpctypes.SimOpts{},
+			expErrCode: rpctypes.ErrCodeInvalidParams,
+		},
+		{
+			name: "gas budget exhausted",
+			opts: rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &recipient}, {From: &sender, To: &recipient}}},
+				},
+			},
+			// the first transfer spends the whole budget
+			gasCap:     21000,
+			expErrCode: rpctypes.ErrCodeClientLimitExceeded,
+		},
+		{
+			name: "block numbers not in order",
+			opts: rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(baseHeight + 2))}},
+					{BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(baseHeight + 1))}},
+				},
+			},
+			expErrCode: rpctypes.ErrCodeBlockNumberInvalid,
+		},
+		{
+			name: "nonce too high in validation mode",
+			opts: rpctypes.SimOpts{
+				Validation: true,
+				BlockStateCalls: []rpctypes.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Nonce: &highNonce}}},
+				},
+			},
+			expErrCode: rpctypes.ErrCodeNonceTooHigh,
+		},
+		{
+			name: "chained state and gap filling",
+			opts: rpctypes.SimOpts{
+				TraceTransfers: true,
+				BlockStateCalls: []rpctypes.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &intermediate, Value: oneEther}}},
+					{
+						BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(baseHeight + 3))},
+						Calls:          []types.TransactionArgs{{From: &intermediate, To: &recipient, Value: halfEther}},
+					},
+				},
+			},
+			expBlocks: 3,
+			checkResults: func(blocks []*rpctypes.SimBlockResult) {
+				for i, block := range blocks {
+					s.Require().Equal(baseHeight+int64(i)+1, block.Header.Number.Int64())
+					if i > 0 {
+						s.Require().Equal(blocks[i-1].Header.Hash(), block.Header.ParentHash)
+						s.Require().Greater(block.Header.Time, blocks[i-1].Header.Time)
+					}
+				}
+				// the gap between the two blocks is filled with an empty block
+				s.Require().Empty(blocks[1].Calls)
+
+				first := blocks[0].Calls[0]
+				s.Require().Nil(first.Error)
+				s.Require().Len(first.Logs, 1)
+				s.Require().Equal(common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), first.Logs[0].Address)
+
+				// the second transfer can only succeed on top of the first one
+				second := blocks[2].Calls[0]
+				s.Require().Nil(second.Error)
+				s.Require().Equal(hexutil.Uint64(1), second.Status)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(tc.opts)
+			s.Require().NoError(err)
+
+			gasCap := tc.gasCap
+			if gasCap == 0 {
+				gasCap = config.DefaultGasCap
+			}
+			req := &types.SimulateV1Request{Opts: opts, GasCap: gasCap}
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), req)
+			s.Require().NoError(err)
+
+			if tc.expErrCode != 0 {
+				s.Require().Equal(tc.expErrCode, res.ErrorCode, res.Error)
+				return
+			}
+			s.Require().Empty(res.Error)
+
+			var blocks []*rpctypes.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Data, &blocks))
+			s.Require().Len(blocks, tc.expBlocks)
+			tc.checkResults(blocks)
+		})
+	}
+
+	// the simulated state changes are never persisted
+	balance := s.Network.App.GetEVMKeeper().GetBalance(s.Network.GetContext(), intermediate)
+	s.Require().True(balance.IsZero())
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -2350,6 +2592,83 @@ func (s *KeeperTestSuite) TestBalance() {
 	}
 }
 
+func (s *KeeperTestSuite) TestPendingTxs() {
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		name       string
+		nonceShift uint64
+		expBalance string
+		expVMError bool
+	}{
+		{
+			"pending transfer applied",
+			0,
+			amount.String(),
+			false,
+		},
+		{
+			"pending transfer with nonce gap skipped",
+			1,
+			"0",
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest()
+
+			senderKey := s.Keyring.GetKey(0)
+			recipient := tx.GenerateAddress()
+
+			nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), senderKey.Addr) + tc.nonceShift
+			txMsg, err := s.Factory.GenerateSignedMsgEthereumTx(senderKey.Priv, types.EvmTxArgs{
+				To:     &recipient,
+				Amount: amount,
+				Nonce:  nonce,
+			})
+			s.Require().NoError(err)
+			pendingTxs := []*types.MsgEthereumTx{&txMsg}
+
+			res, err := s.Network.App.GetEVMKeeper().Balance(s.Network.GetContext(), &types.QueryBalanceRequest{
+				Address:    recipient.String(),
+				PendingTxs: pendingTxs,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expBalance, res.Balance)
+
+			// the pending state is discarded after the query
+			res, err = s.Network.App.GetEVMKeeper().Balance(s.Network.GetContext(), &types.QueryBalanceRequest{Address: recipient.String()})
+			s.Require().NoError(err)
+			s.Require().Equal("0", res.Balance)
+
+			// the recipient spends the pending transfer
+			to := s.Keyring.GetAddr(1)
+			args, err := json.Marshal(&types.TransactionArgs{
+				From:  &recipient,
+				To:    &to,
+				Value: (*hexutil.Big)(amount),
+			})
+			s.Require().NoError(err)
+			callRes, err := s.Network.App.GetEVMKeeper().EthCall(s.Network.GetContext(), &types.EthCallRequest{
+				Args:       args,
+				GasCap:     config.DefaultGasCap,
+				PendingTxs: pendingTxs,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expVMError, callRes.Failed())
+
+			// the writes of the call are discarded from the cached pending state
+			res, err = s.Network.App.GetEVMKeeper().Balance(s.Network.GetContext(), &types.QueryBalanceRequest{
+				Address:    recipient.String(),
+				PendingTxs: pendingTxs,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expBalance, res.Balance)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestEmptyRequest() {
 	s.SetupTest()
 	k := s.Network.App.GetEVMKeeper()
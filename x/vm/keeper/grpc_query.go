@@ -11,11 +11,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
@@ -32,8 +34,11 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -45,6 +50,9 @@ const (
 	maxTracePredecessors = 10_000
 
 	maxPredecessorGas = uint64(50_000_000)
+
+	// maxPendingTxs is the maximum amount of pending block transactions applied before a query.
+	maxPendingTxs = 10_000
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -165,6 +173,17 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (_ *t
 		)
 	}
 
+	if len(req.PendingTxs) > 0 {
+		cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, nil))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		ctx, err = k.applyPendingTxs(ctx, cfg, req.PendingTxs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	balanceInt := k.SpendableCoin(ctx, common.HexToAddress(req.Address))
 
 	span.AddEvent("balance", trace.WithAttributes(attribute.String("balance", balanceInt.String())))
@@ -205,6 +224,136 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (_ *t
 	}, nil
 }
 
+// StorageRange implements the Query/StorageRange gRPC method. The storage
+// slots of the account are returned in the order of their keys.
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (_ *types.QueryStorageRangeResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "StorageRange", trace.WithAttributes(
+		attribute.String("address", req.Address),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	address := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+
+	var storage []types.State
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Accounts implements the Query/Accounts gRPC method. The accounts are returned
+// in the order of their addresses, the accounts which address is not an
+// Ethereum address are skipped.
+func (k Keeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (_ *types.QueryAccountsResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "Accounts")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if len(req.Addresses) > types.MaxAccountsQueryAddresses {
+		return nil, status.Errorf(codes.InvalidArgument, "too many addresses, got %d, max %d", len(req.Addresses), types.MaxAccountsQueryAddresses)
+	}
+	if len(req.Addresses) > 0 {
+		accounts := make([]types.EthAccount, len(req.Addresses))
+		for i, address := range req.Addresses {
+			if err := utils.ValidateAddress(address); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			addr := common.HexToAddress(address)
+			acct := k.GetAccountOrEmpty(ctx, addr)
+			accounts[i] = types.EthAccount{
+				Address:     addr.Hex(),
+				Balance:     acct.Balance.String(),
+				CodeHash:    common.BytesToHash(acct.CodeHash).Hex(),
+				Nonce:       acct.Nonce,
+				StorageRoot: k.storageRoot(ctx, addr).Hex(),
+			}
+		}
+		return &types.QueryAccountsResponse{Accounts: accounts}, nil
+	}
+
+	pageReq := req.Pagination
+	if pageReq != nil && len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+	authKey, ok := k.storeKeys[authtypes.StoreKey]
+	if !ok {
+		return nil, status.Error(codes.Internal, "auth store key not found")
+	}
+
+	// the auth store is keyed by account address, so the iteration starts at
+	// the page key
+	store := prefix.NewStore(ctx.KVStore(authKey), authtypes.AddressStoreKeyPrefix)
+
+	var accounts []types.EthAccount
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+		if len(key) != common.AddressLength {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+
+		addr := common.BytesToAddress(key)
+		acct := k.accountKeeper.GetAccount(ctx, key)
+		if acct == nil {
+			return false, fmt.Errorf("account %s not found", addr)
+		}
+		accounts = append(accounts, types.EthAccount{
+			Address:     addr.Hex(),
+			Balance:     k.SpendableCoin(ctx, addr).String(),
+			CodeHash:    k.GetCodeHash(ctx, addr).Hex(),
+			Nonce:       acct.GetSequence(),
+			StorageRoot: k.storageRoot(ctx, addr).Hex(),
+		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// storageRoot returns the empty trie root if the account has no storage.
+// Cosmos EVM doesn't keep storage tries, so the zero hash is returned otherwise.
+func (k Keeper) storageRoot(ctx sdk.Context, addr common.Address) common.Hash {
+	root := ethtypes.EmptyRootHash
+	k.ForEachStorage(ctx, addr, func(_, _ common.Hash) bool {
+		root = common.Hash{}
+		return false
+	})
+	return root
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (_ *types.QueryCodeResponse, err error) {
 	if req == nil {
@@ -279,6 +428,11 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (_ *types.
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	ctx, err = k.applyPendingTxs(ctx, cfg, req.PendingTxs)
+	if err != nil {
+		return nil, err
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -368,6 +522,11 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	ctx, err = k.applyPendingTxs(ctx, cfg, req.PendingTxs)
+	if err != nil {
+		return nil, err
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -916,6 +1075,42 @@ func (k *Keeper) traceTxWithMsg(
 	return &result, nil
 }
 
+// SimulateV1 implements eth_simulateV1 rpc api.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (_ *types.SimulateV1Response, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "SimulateV1", trace.WithAttributes(attribute.String("proposer", req.ProposerAddress.String())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results, err := k.simulateV1(ctx, &opts, cfg, req.GasCap)
+	if err != nil {
+		var simErr *rpctypes.SimError
+		if errors.As(err, &simErr) {
+			return &types.SimulateV1Response{Error: simErr.Message, ErrorCode: int64(simErr.Code)}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.SimulateV1Response{Data: data}, nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -953,6 +1148,72 @@ func (k Keeper) Config(ctx context.Context, _ *types.QueryConfigRequest) (*types
 	return &types.QueryConfigResponse{Config: config}, nil
 }
 
+// applyPendingTxs applies the transactions of the pending block in order on
+// top of the state of ctx, to query the pending state. As done by the ante
+// handler, the sender nonce is incremented and the fee of the gas used is
+// charged. The transactions that cannot be applied, e.g. because of a nonce
+// gap or an insufficient balance, are skipped. The returned context branches
+// off ctx so the pending state is never committed. The pending state is cached
+// until the height or the pending txs change, since the queries of the pending
+// block apply the same txs on top of the same committed state.
+func (k Keeper) applyPendingTxs(ctx sdk.Context, cfg *statedb.EVMConfig, txs []*types.MsgEthereumTx) (sdk.Context, error) {
+	if len(txs) == 0 {
+		return ctx, nil
+	}
+	if len(txs) > maxPendingTxs {
+		return ctx, status.Errorf(codes.InvalidArgument, "too many pending txs, got %d: limit %d", len(txs), maxPendingTxs)
+	}
+
+	key := pendingStateKey(ctx, cfg, txs)
+	if store, ok := k.pendingStates.get(ctx.BlockHeight(), key); ok {
+		return ctx.WithMultiStore(store), nil
+	}
+
+	pendingStore := ctx.MultiStore().CacheMultiStore()
+	ctx = ctx.WithMultiStore(pendingStore)
+	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	for i, tx := range txs {
+		ethTx := tx.AsTransaction()
+		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		txConfig := statedb.NewEmptyTxConfig()
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i) //#nosec G115 -- bounded by maxPendingTxs
+
+		// the pending txs write to the branched store of ctx, with the gas
+		// meter of a simulated tx
+		txCtx := buildTraceCtx(ctx, msg.GasLimit)
+		stateDB := statedb.New(txCtx, &k, txConfig)
+		if stateDB.GetNonce(msg.From) != msg.Nonce {
+			continue
+		}
+		maxFee := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)
+		cost, overflow := uint256.FromBig(maxFee.Add(maxFee, msg.Value))
+		if overflow || stateDB.GetBalance(msg.From).Cmp(cost) < 0 {
+			continue
+		}
+
+		stateDB.SetNonce(msg.From, msg.Nonce+1, tracing.NonceChangeEoACall)
+		rsp, err := k.ApplyMessageWithConfig(txCtx, stateDB, *msg, nil, true, false, cfg, txConfig, false, nil)
+		if err != nil {
+			continue
+		}
+
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(rsp.GasUsed), msg.GasPrice)
+		feeDB := statedb.New(txCtx, &k, txConfig)
+		feeDB.SubBalance(msg.From, uint256.MustFromBig(fee), tracing.BalanceDecreaseGasBuy)
+		if err := feeDB.Commit(); err != nil {
+			return ctx, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// the query writes to a branch of the cached state
+	k.pendingStates.add(ctx.BlockHeight(), key, pendingStore)
+	return ctx.WithMultiStore(pendingStore.CacheMultiStore()), nil
+}
+
 // buildTraceCtx builds a context for simulating or tracing transactions by:
 // 1. assigning a new infinite gas meter with the provided gasLimit
 // 2. calling BuildEvmExecutionCtx to set up gas configs consistent with Ethereum transaction execution.
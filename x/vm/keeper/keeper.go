@@ -86,6 +86,9 @@ type Keeper struct {
 	// virtualFeeCollection enabling will use "Virtual" methods from the bank module to accumulate
 	// fees to the fee collector module in the endBlocker instead of using regular sends during tx execution.
 	virtualFeeCollection bool
+
+	// pendingStates caches the pending state applied by the queries of the pending block.
+	pendingStates *pendingStateCache
 }
 
 // NewKeeper generates new evm module keeper
@@ -140,6 +143,7 @@ func NewKeeper(
 		consensusKeeper:  consensusKeeper,
 		erc20Keeper:      erc20Keeper,
 		storeKeys:        storeKeys,
+		pendingStates:    &pendingStateCache{},
 	}
 }
 
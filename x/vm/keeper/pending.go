@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pendingStateCache holds the state of the latest pending block applied by a
+// query, so that the following queries of the pending state at the same height
+// branch off it instead of applying the pending txs again. The pending block
+// changes with the height and the txs of the mempool, and only the last one is
+// kept.
+type pendingStateCache struct {
+	mu     sync.Mutex
+	height int64
+	key    common.Hash
+	store  storetypes.CacheMultiStore
+}
+
+// pendingStateKey identifies the state resulting from applying the txs on top
+// of the state of ctx, with the EVM config of the query.
+func pendingStateKey(ctx sdk.Context, cfg *statedb.EVMConfig, txs []*types.MsgEthereumTx) common.Hash {
+	var time [8]byte
+	binary.BigEndian.PutUint64(time[:], uint64(ctx.BlockTime().UnixNano())) //#nosec G115 -- only used as a cache key
+
+	data := make([][]byte, 0, len(txs)+3)
+	data = append(data, time[:], cfg.CoinBase.Bytes())
+	if cfg.BaseFee != nil {
+		data = append(data, cfg.BaseFee.Bytes())
+	}
+	for _, tx := range txs {
+		data = append(data, tx.Hash().Bytes())
+	}
+	return crypto.Keccak256Hash(data...)
+}
+
+// get returns a branch of the cached pending state identified by key at the
+// height, if any.
+func (c *pendingStateCache) get(height int64, key common.Hash) (storetypes.CacheMultiStore, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.store == nil || c.height != height || c.key != key {
+		return nil, false
+	}
+	return c.store.CacheMultiStore(), true
+}
+
+// add caches the pending state identified by key at the height. The store must
+// not be written afterwards.
+func (c *pendingStateCache) add(height int64, key common.Hash, store storetypes.CacheMultiStore) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.height, c.key, c.store = height, key, store
+}
@@ -0,0 +1,591 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// transferAddress is the address of the pseudo-contract which emits the
+	// ERC-7528 transfer logs of native value transfers.
+	transferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// transferTopic is the topic of the ERC-20 Transfer(address,address,uint256) event.
+	transferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+)
+
+// simulator runs the blocks of an eth_simulateV1 request on top of the state
+// of the base block. State changes are chained across calls and blocks and
+// are never persisted.
+type simulator struct {
+	k              *Keeper
+	cfg            *statedb.EVMConfig
+	chainID        *big.Int
+	validate       bool
+	traceTransfers bool
+	// budget is the remaining amount of gas that can be spent across all the
+	// simulated calls.
+	budget uint64
+	// base is the header of the block on top of which the simulation runs.
+	base *ethtypes.Header
+}
+
+// simulateV1 executes the given eth_simulateV1 options on top of the block of
+// the given context.
+func (k *Keeper) simulateV1(ctx sdk.Context, opts *rpctypes.SimOpts, cfg *statedb.EVMConfig, gasCap uint64) ([]*rpctypes.SimBlockResult, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, rpctypes.NewSimError(rpctypes.ErrCodeInvalidParams, "empty input")
+	} else if len(opts.BlockStateCalls) > rpctypes.MaxSimulateBlocks {
+		return nil, rpctypes.NewSimError(rpctypes.ErrCodeClientLimitExceeded, "too many blocks")
+	}
+
+	// all the state transitions are written to a cached context which is
+	// discarded once the simulation finishes
+	ctx, _ = k.SetConsensusParamsInCtx(ctx).CacheContext()
+
+	if gasCap == 0 {
+		gasCap = math.MaxUint64
+	}
+	sim := &simulator{
+		k:              k,
+		cfg:            cfg,
+		chainID:        types.GetEthChainConfig().ChainID,
+		validate:       opts.Validation,
+		traceTransfers: opts.TraceTransfers,
+		budget:         gasCap,
+		base: &ethtypes.Header{
+			Number:   big.NewInt(ctx.BlockHeight()),
+			Time:     uint64(ctx.BlockHeader().Time.Unix()), //#nosec G115 -- int overflow is not a concern here
+			GasLimit: antetypes.BlockGasLimit(ctx),
+			BaseFee:  cfg.BaseFee,
+		},
+	}
+
+	blocks, err := sim.sanitizeChain(opts.BlockStateCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	parentHash := k.GetHashFn(ctx)(sim.base.Number.Uint64())
+	results := make([]*rpctypes.SimBlockResult, len(blocks))
+	for i := range blocks {
+		res, err := sim.processBlock(ctx, &blocks[i], parentHash)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = res
+		parentHash = res.Header.Hash()
+	}
+	return results, nil
+}
+
+// sanitizeChain checks the block numbers and timestamps of the simulated
+// blocks, assigning defaults to the missing ones and filling the gaps between
+// non-consecutive block numbers with empty blocks.
+func (s *simulator) sanitizeChain(blocks []rpctypes.SimBlock) ([]rpctypes.SimBlock, error) {
+	var (
+		res           = make([]rpctypes.SimBlock, 0, len(blocks))
+		prevNumber    = s.base.Number
+		prevTimestamp = s.base.Time
+		totalCalls    int
+	)
+	for _, block := range blocks {
+		if len(block.Calls) > rpctypes.MaxSimulateCallsPerBlock {
+			return nil, rpctypes.NewSimError(rpctypes.ErrCodeClientLimitExceeded, "too many calls in block")
+		}
+		totalCalls += len(block.Calls)
+		if totalCalls > rpctypes.MaxSimulateTotalCalls {
+			return nil, rpctypes.NewSimError(rpctypes.ErrCodeClientLimitExceeded, "too many calls")
+		}
+
+		if block.BlockOverrides == nil {
+			block.BlockOverrides = new(rpctypes.BlockOverrides)
+		}
+		overrides := block.BlockOverrides
+		if err := overrides.Validate(); err != nil {
+			return nil, rpctypes.NewSimError(rpctypes.ErrCodeInvalidParams, err.Error())
+		}
+		if overrides.Number == nil {
+			overrides.Number = (*hexutil.Big)(new(big.Int).Add(prevNumber, big.NewInt(1)))
+		}
+
+		number := overrides.Number.ToInt()
+		diff := new(big.Int).Sub(number, prevNumber)
+		if diff.Sign() <= 0 {
+			return nil, rpctypes.NewSimError(
+				rpctypes.ErrCodeBlockNumberInvalid,
+				fmt.Sprintf("block numbers must be in order: %s <= %s", number, prevNumber),
+			)
+		}
+		if total := new(big.Int).Sub(number, s.base.Number); total.Cmp(big.NewInt(rpctypes.MaxSimulateBlocks)) > 0 {
+			return nil, rpctypes.NewSimError(rpctypes.ErrCodeClientLimitExceeded, "too many blocks")
+		}
+
+		// fill the gap with empty blocks
+		for i := int64(1); i < diff.Int64(); i++ {
+			n := new(big.Int).Add(prevNumber, big.NewInt(i))
+			t := prevTimestamp + rpctypes.SimulateTimestampIncrement
+			res = append(res, rpctypes.SimBlock{
+				BlockOverrides: &rpctypes.BlockOverrides{
+					Number: (*hexutil.Big)(n),
+					Time:   (*hexutil.Uint64)(&t),
+				},
+			})
+			prevTimestamp = t
+		}
+
+		prevNumber = number
+		if overrides.Time == nil {
+			t := prevTimestamp + rpctypes.SimulateTimestampIncrement
+			overrides.Time = (*hexutil.Uint64)(&t)
+		} else if t := uint64(*overrides.Time); t <= prevTimestamp {
+			return nil, rpctypes.NewSimError(
+				rpctypes.ErrCodeBlockTimestampInvalid,
+				fmt.Sprintf("block timestamps must be in order: %d <= %d", t, prevTimestamp),
+			)
+		}
+		prevTimestamp = uint64(*overrides.Time)
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// processBlock applies the state overrides and executes the calls of a single
+// simulated block.
+func (s *simulator) processBlock(ctx sdk.Context, block *rpctypes.SimBlock, parentHash common.Hash) (*rpctypes.SimBlockResult, error) {
+	overrides := block.BlockOverrides
+	number := overrides.Number.ToInt()
+	if !number.IsInt64() {
+		return nil, rpctypes.NewSimError(rpctypes.ErrCodeBlockNumberInvalid, fmt.Sprintf("invalid block number %s", number))
+	}
+	blockTime := uint64(*overrides.Time)
+	if blockTime > math.MaxInt64 {
+		return nil, rpctypes.NewSimError(rpctypes.ErrCodeBlockTimestampInvalid, fmt.Sprintf("invalid block timestamp %d", blockTime))
+	}
+	ctx = ctx.
+		WithBlockHeight(number.Int64()).
+		WithBlockTime(time.Unix(int64(blockTime), 0).UTC()) //#nosec G115 -- checked above
+
+	gasLimit := s.base.GasLimit
+	if overrides.GasLimit != nil {
+		gasLimit = uint64(*overrides.GasLimit)
+		ctx = withBlockMaxGas(ctx, gasLimit)
+	}
+
+	cfg := *s.cfg
+	cfg.FeeMarketParams.MinGasMultiplier = sdkmath.LegacyZeroDec()
+	cfg.FeeMarketParams.NoBaseFee = !s.validate
+	if overrides.FeeRecipient != nil {
+		cfg.CoinBase = *overrides.FeeRecipient
+	}
+	switch {
+	case overrides.BaseFeePerGas != nil:
+		cfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+	case !s.validate:
+		// fees are not enforced outside of validation mode
+		cfg.BaseFee = new(big.Int)
+	}
+
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Coinbase:   cfg.CoinBase,
+		Difficulty: big.NewInt(0),
+		Number:     number,
+		GasLimit:   gasLimit,
+		Time:       blockTime,
+		BaseFee:    cfg.BaseFee,
+	}
+
+	precompileOverrides, err := s.applyStateOverrides(ctx, block.StateOverrides, header)
+	if err != nil {
+		return nil, rpctypes.NewSimError(rpctypes.ErrCodeInvalidParams, err.Error())
+	}
+
+	var (
+		gasUsed  uint64
+		logIndex uint
+		txs      = make([]*ethtypes.Transaction, len(block.Calls))
+		senders  = make([]common.Address, len(block.Calls))
+		calls    = make([]rpctypes.SimCallResult, len(block.Calls))
+		receipts = make([]*ethtypes.Receipt, len(block.Calls))
+	)
+	for i := range block.Calls {
+		if err := ctx.Context().Err(); err != nil {
+			return nil, err
+		}
+
+		args := &block.Calls[i]
+		tx, res, logs, err := s.processCall(ctx, &cfg, header, args, uint(i), gasUsed, precompileOverrides)
+		if err != nil {
+			return nil, err
+		}
+		gasUsed += res.GasUsed
+		s.budget -= min(res.GasUsed, s.budget)
+
+		txs[i] = tx
+		senders[i] = args.GetFrom()
+		calls[i] = newSimCallResult(res, logs)
+
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			CumulativeGasUsed: gasUsed,
+			GasUsed:           res.GasUsed,
+			TxHash:            tx.Hash(),
+			TransactionIndex:  uint(i),
+			Logs:              types.LogsToEthereum(res.Logs),
+		}
+		if res.Failed() {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		} else {
+			receipt.Status = ethtypes.ReceiptStatusSuccessful
+		}
+		if tx.To() == nil {
+			receipt.ContractAddress = crypto.CreateAddress(args.GetFrom(), tx.Nonce())
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+		receipts[i] = receipt
+
+		for _, log := range calls[i].Logs {
+			log.Index = logIndex
+			logIndex++
+		}
+	}
+
+	header.GasUsed = gasUsed
+	b := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil))
+	blockHash := b.Hash()
+	for _, call := range calls {
+		for _, log := range call.Logs {
+			log.BlockHash = blockHash
+		}
+	}
+
+	return &rpctypes.SimBlockResult{
+		Header:       b.Header(),
+		Transactions: txs,
+		Senders:      senders,
+		Calls:        calls,
+	}, nil
+}
+
+// applyStateOverrides writes the state overrides of a simulated block to the
+// context, so that they are visible to all the following calls and blocks.
+// It returns the overrides that need to be passed to every call of the block
+// in order to honor the moved and overridden precompiles.
+func (s *simulator) applyStateOverrides(ctx sdk.Context, overrides *rpctypes.StateOverride, header *ethtypes.Header) (*rpctypes.StateOverride, error) {
+	if overrides == nil {
+		return nil, nil
+	}
+
+	rules := types.GetEthChainConfig().Rules(header.Number, true, header.Time)
+	precompiles := s.k.activePrecompiles(ctx, rules)
+
+	var (
+		precompileOverrides = make(rpctypes.StateOverride)
+		stateOverrides      = make(rpctypes.StateOverride, len(*overrides))
+	)
+	for addr, account := range *overrides {
+		if _, isPrecompile := precompiles[addr]; isPrecompile {
+			precompileOverrides[addr] = rpctypes.OverrideAccount{MovePrecompileTo: account.MovePrecompileTo}
+		}
+		if account.State != nil {
+			if account.StateDiff != nil {
+				return nil, fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+			}
+			// the state override replaces the whole storage, so the stored
+			// slots are cleared before applying it as a diff
+			var keys []common.Hash
+			s.k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				s.k.DeleteState(ctx, addr, key)
+			}
+			account.StateDiff, account.State = account.State, nil
+		}
+		stateOverrides[addr] = account
+	}
+
+	stateDB := statedb.New(ctx, s.k, statedb.NewEmptyTxConfig())
+	if err := stateOverrides.Apply(stateDB, precompiles); err != nil {
+		return nil, err
+	}
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
+	if len(precompileOverrides) == 0 {
+		return nil, nil
+	}
+	return &precompileOverrides, nil
+}
+
+// processCall executes a single simulated call and commits its state changes
+// to the context. Errors returned by this method abort the whole simulation.
+func (s *simulator) processCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	header *ethtypes.Header,
+	args *types.TransactionArgs,
+	txIndex uint,
+	gasUsed uint64,
+	overrides *rpctypes.StateOverride,
+) (*ethtypes.Transaction, *types.MsgEthereumTxResponse, []*ethtypes.Log, error) {
+	from := args.GetFrom()
+	if args.Nonce == nil {
+		nonce := s.k.GetNonce(ctx, from)
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+	remaining := header.GasLimit - gasUsed
+	if args.Gas == nil {
+		args.Gas = (*hexutil.Uint64)(&remaining)
+	}
+	if uint64(*args.Gas) > remaining {
+		return nil, nil, nil, rpctypes.NewSimError(
+			rpctypes.ErrCodeBlockGasLimitReached,
+			fmt.Sprintf("block gas limit reached: %d >= %d", gasUsed+uint64(*args.Gas), header.GasLimit),
+		)
+	}
+	// a zero cap means no cap to CallDefaults, so an exhausted budget is an
+	// error rather than a zero cap
+	if s.budget == 0 {
+		return nil, nil, nil, rpctypes.NewSimError(rpctypes.ErrCodeClientLimitExceeded, "gas budget exhausted")
+	}
+	if err := args.CallDefaults(s.budget, header.BaseFee, s.chainID); err != nil {
+		return nil, nil, nil, rpctypes.NewSimError(rpctypes.ErrCodeInvalidParams, err.Error())
+	}
+
+	tx := args.ToTransaction(ethtypes.DynamicFeeTxType)
+	msg := args.ToMessage(header.BaseFee, !s.validate, !s.validate)
+	txConfig := statedb.NewTxConfig(tx.Hash(), txIndex)
+	stateDB := statedb.New(ctx, s.k, txConfig)
+	if s.validate {
+		if err := validateSimMessage(stateDB, msg, header.BaseFee); err != nil {
+			return nil, nil, nil, rpctypes.SimTxValidationError(err)
+		}
+	}
+
+	// buy the gas upfront, the unused part is refunded after the execution
+	gasLimit := new(big.Int).SetUint64(msg.GasLimit)
+	balanceCheck := new(big.Int).Mul(gasLimit, msg.GasFeeCap)
+	balanceCheck.Add(balanceCheck, msg.Value)
+	if balance := stateDB.GetBalance(from).ToBig(); balance.Cmp(balanceCheck) < 0 {
+		return nil, nil, nil, rpctypes.SimTxValidationError(
+			fmt.Errorf("%w: address %v have %v want %v", core.ErrInsufficientFunds, from.Hex(), balance, balanceCheck),
+		)
+	}
+	gasCost := new(big.Int).Mul(gasLimit, msg.GasPrice)
+	stateDB.SubBalance(from, uint256.MustFromBig(gasCost), tracing.BalanceDecreaseGasBuy)
+
+	// ApplyMessageWithConfig only manages the sender nonce of contract creations
+	if msg.To != nil {
+		stateDB.SetNonce(from, stateDB.GetNonce(from)+1, tracing.NonceChangeEoACall)
+	}
+
+	var (
+		hooks    *tracing.Hooks
+		transfer *transferTracer
+	)
+	if s.traceTransfers {
+		transfer = newTransferTracer(stateDB)
+		hooks = transfer.Hooks()
+	}
+
+	res, err := s.k.ApplyMessageWithConfig(ctx, stateDB, *msg, hooks, false, false, cfg, txConfig, false, overrides)
+	if err != nil {
+		return nil, nil, nil, rpctypes.SimTxValidationError(err)
+	}
+
+	leftover := new(big.Int).SetUint64(msg.GasLimit - res.GasUsed)
+	stateDB.AddBalance(from, uint256.MustFromBig(leftover.Mul(leftover, msg.GasPrice)), tracing.BalanceIncreaseGasReturn)
+
+	logs := stateDB.Logs()
+	if transfer != nil {
+		logs = transfer.merge(logs)
+	}
+	for _, log := range logs {
+		log.TxHash = tx.Hash()
+		log.TxIndex = txIndex
+		log.BlockNumber = header.Number.Uint64()
+		log.BlockTimestamp = header.Time
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return nil, nil, nil, err
+	}
+	return tx, res, logs, nil
+}
+
+// validateSimMessage performs the transaction checks that are otherwise done
+// by the ante handler, for the simulations which run in validation mode.
+func validateSimMessage(stateDB *statedb.StateDB, msg *core.Message, baseFee *big.Int) error {
+	stNonce := stateDB.GetNonce(msg.From)
+	if msg.Nonce < stNonce {
+		return fmt.Errorf("%w: address %v, tx: %d state: %d", core.ErrNonceTooLow, msg.From.Hex(), msg.Nonce, stNonce)
+	} else if msg.Nonce > stNonce {
+		return fmt.Errorf("%w: address %v, tx: %d state: %d", core.ErrNonceTooHigh, msg.From.Hex(), msg.Nonce, stNonce)
+	}
+	if code := stateDB.GetCode(msg.From); len(code) > 0 {
+		if _, delegated := ethtypes.ParseDelegation(code); !delegated {
+			return fmt.Errorf("%w: address %v, len(code): %d", core.ErrSenderNoEOA, msg.From.Hex(), len(code))
+		}
+	}
+	if msg.GasFeeCap.Cmp(msg.GasTipCap) < 0 {
+		return fmt.Errorf("%w: address %v, maxPriorityFeePerGas: %s, maxFeePerGas: %s", core.ErrTipAboveFeeCap, msg.From.Hex(), msg.GasTipCap, msg.GasFeeCap)
+	}
+	if baseFee != nil && msg.GasFeeCap.Cmp(baseFee) < 0 {
+		return fmt.Errorf("%w: address %v, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, msg.From.Hex(), msg.GasFeeCap, baseFee)
+	}
+	return nil
+}
+
+// newSimCallResult converts the response of a simulated call to its
+// eth_simulateV1 representation.
+func newSimCallResult(res *types.MsgEthereumTxResponse, logs []*ethtypes.Log) rpctypes.SimCallResult {
+	result := rpctypes.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		MaxUsedGas:  hexutil.Uint64(res.MaxUsedGas),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if !res.Failed() {
+		return result
+	}
+
+	result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		revertErr := types.NewExecErrorWithReason(res.Ret)
+		result.Error = &rpctypes.SimCallError{
+			Message: revertErr.Error(),
+			Code:    revertErr.ErrorCode(),
+			Data:    revertErr.ErrorData().(string),
+		}
+	} else {
+		result.Error = &rpctypes.SimCallError{
+			Message: res.VmError,
+			Code:    rpctypes.ErrCodeVMError,
+		}
+	}
+	return result
+}
+
+// withBlockMaxGas returns a copy of the context with the consensus block gas
+// limit set to the given value.
+func withBlockMaxGas(ctx sdk.Context, gasLimit uint64) sdk.Context {
+	cp := ctx.ConsensusParams()
+	blockParams := cp.Block
+	if blockParams == nil {
+		blockParams = new(tmproto.BlockParams)
+	} else {
+		cpy := *blockParams
+		blockParams = &cpy
+	}
+	if gasLimit > math.MaxInt64 {
+		blockParams.MaxGas = -1
+	} else {
+		blockParams.MaxGas = int64(gasLimit)
+	}
+	cp.Block = blockParams
+	return ctx.WithConsensusParams(cp)
+}
+
+// transferLog is an ERC-7528 transfer log, together with the number of EVM
+// logs that were emitted before it within the same call.
+type transferLog struct {
+	position int
+	log      *ethtypes.Log
+}
+
+// transferTracer records native value transfers of a simulated call as
+// ERC-7528 logs. The logs of reverted call frames are discarded.
+type transferTracer struct {
+	stateDB *statedb.StateDB
+	frames  [][]transferLog
+	logs    []transferLog
+}
+
+func newTransferTracer(stateDB *statedb.StateDB) *transferTracer {
+	return &transferTracer{stateDB: stateDB}
+}
+
+// Hooks returns the tracing hooks of the transfer tracer.
+func (t *transferTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnEnter: t.onEnter,
+		OnExit:  t.onExit,
+	}
+}
+
+func (t *transferTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	var frame []transferLog
+	if vm.OpCode(typ) != vm.DELEGATECALL && value != nil && value.Sign() > 0 {
+		frame = append(frame, transferLog{
+			position: len(t.stateDB.Logs()),
+			log: &ethtypes.Log{
+				Address: transferAddress,
+				Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+				Data:    common.BigToHash(value).Bytes(),
+			},
+		})
+	}
+	t.frames = append(t.frames, frame)
+}
+
+func (t *transferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	size := len(t.frames)
+	if size == 0 {
+		return
+	}
+	frame := t.frames[size-1]
+	t.frames = t.frames[:size-1]
+	switch {
+	case reverted:
+		return
+	case size == 1:
+		t.logs = frame
+	default:
+		t.frames[size-2] = append(t.frames[size-2], frame...)
+	}
+}
+
+// merge interleaves the recorded transfer logs with the given EVM logs in
+// the order in which they were emitted.
+func (t *transferTracer) merge(logs []*ethtypes.Log) []*ethtypes.Log {
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(t.logs))
+	j := 0
+	for i, log := range logs {
+		for ; j < len(t.logs) && t.logs[j].position <= i; j++ {
+			merged = append(merged, t.logs[j].log)
+		}
+		merged = append(merged, log)
+	}
+	for ; j < len(t.logs); j++ {
+		merged = append(merged, t.logs[j].log)
+	}
+	return merged
+}
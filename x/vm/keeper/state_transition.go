@@ -414,14 +414,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, stateDB *statedb.StateD
 	// Gas limit suffices for the floor data cost (EIP-7623)
 	rules := ethCfg.Rules(evm.Context.BlockNumber, true, evm.Context.Time)
 	if overrides != nil {
-		precompiles := vm.ActivePrecompiledContracts(rules)
-		params := k.GetParams(ctx)
-		for _, precompileAddr := range params.ActiveStaticPrecompiles {
-			address := common.HexToAddress(precompileAddr)
-			if precompile, found := k.precompiles[address]; found {
-				precompiles[address] = precompile
-			}
-		}
+		precompiles := k.activePrecompiles(ctx, rules)
 		if err := overrides.Apply(stateDB, precompiles); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to apply state override")
 		}
@@ -6,8 +6,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // WithStaticPrecompiles sets the available static precompiled contracts.
@@ -53,3 +56,17 @@ func (k Keeper) IsAvailableStaticPrecompile(params *types.Params, address common
 	return slices.Contains(params.ActiveStaticPrecompiles, address.String()) ||
 		slices.Contains(vm.PrecompiledAddressesPrague, address)
 }
+
+// activePrecompiles returns the precompiled contracts that are active for the
+// given rules, including the static precompiles enabled on the module params.
+func (k *Keeper) activePrecompiles(ctx sdk.Context, rules params.Rules) vm.PrecompiledContracts {
+	precompiles := vm.ActivePrecompiledContracts(rules)
+	moduleParams := k.GetParams(ctx)
+	for _, precompileAddr := range moduleParams.ActiveStaticPrecompiles {
+		address := common.HexToAddress(precompileAddr)
+		if precompile, found := k.precompiles[address]; found {
+			precompiles[address] = precompile
+		}
+	}
+	return precompiles
+}
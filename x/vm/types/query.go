@@ -1,5 +1,9 @@
 package types
 
+// MaxAccountsQueryAddresses is the maximum number of addresses of a single
+// Query/Accounts request.
+const MaxAccountsQueryAddresses = 256
+
 // Failed returns if the contract execution failed in vm errors
 func (egr EstimateGasResponse) Failed() bool {
 	return len(egr.VmError) > 0